@@ -23,6 +23,7 @@ var (
 	_ NestedAttribute                              = MapNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapNestedAttribute{}
 	_ fwxschema.AttributeWithMapValidators         = MapNestedAttribute{}
+	_ fwschema.NestedAttributeWithItemBounds       = MapNestedAttribute{}
 )
 
 // MapNestedAttribute represents an attribute that is a set of objects where
@@ -138,6 +139,16 @@ type MapNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of elements which must be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements which can be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no maximum.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -190,6 +201,16 @@ func (a MapNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (a MapNestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (a MapNestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (a MapNestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
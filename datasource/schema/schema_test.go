@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -422,6 +423,274 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		other    fwschema.Schema
+		expected bool
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			other:    schema.Schema{},
+			expected: true,
+		},
+		"different-type": {
+			schema:   schema.Schema{},
+			other:    testschema.Schema{},
+			expected: false,
+		},
+		"nil-and-empty-attributes": {
+			schema: schema.Schema{},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expected: true,
+		},
+		"attributes-equal": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: true,
+		},
+		"attributes-different-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr1": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr2": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-length": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+		"attributes-different-attribute-type": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-options": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional:  true,
+									Sensitive: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-nesting-mode": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"blocks-equal": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"blocks-different-nested-attributes": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"nil-and-empty-blocks": {
+			schema: schema.Schema{},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{},
+			},
+			expected: true,
+		},
+		"different-description": {
+			schema: schema.Schema{
+				Description: "test description",
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+		"different-deprecation-message": {
+			schema: schema.Schema{
+				DeprecationMessage: "test deprecation message",
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			// The Equal method should also be used by go-cmp.
+			if testCase.expected && !cmp.Equal(testCase.schema, testCase.other) {
+				t.Errorf("expected cmp.Equal to return true, got false")
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1013,7 +1282,9 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1056,7 +1327,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1090,8 +1363,11 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -1101,7 +1377,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1110,7 +1388,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1133,10 +1413,82 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-required-and-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is both Required and Computed. "+
+						"Required attributes must be configured and cannot be set by the provider. Use Optional and Computed instead to allow the provider to set a value when it is not configured.",
+				),
+			},
+		},
+		"attribute-required-and-computed-outputs": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"test_optional_computed": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+					},
+					"test_required": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"attribute-missing-required-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
+		"attribute-required-and-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is both Required and Optional. "+
+						"Set only one of these fields, depending on whether the attribute must be configured.",
+				),
+			},
+		},
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1165,13 +1517,37 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"nested-attribute-missing-required-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test": schema.StringAttribute{},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -1190,7 +1566,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1212,7 +1590,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -1272,6 +1652,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 								},
 							},
 						},
+						Optional: true,
 					},
 				},
 			},
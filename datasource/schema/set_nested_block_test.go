@@ -354,6 +354,86 @@ func TestSetNestedBlockGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestSetNestedBlockGetMaxItems(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    schema.SetNestedBlock
+		expected int64
+	}{
+		"no-max-items": {
+			block: schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"testattr": schema.StringAttribute{},
+					},
+				},
+			},
+			expected: 0,
+		},
+		"max-items": {
+			block: schema.SetNestedBlock{
+				MaxItems: 2,
+			},
+			expected: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.block.GetMaxItems()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetNestedBlockGetMinItems(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    schema.SetNestedBlock
+		expected int64
+	}{
+		"no-min-items": {
+			block: schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"testattr": schema.StringAttribute{},
+					},
+				},
+			},
+			expected: 0,
+		},
+		"min-items": {
+			block: schema.SetNestedBlock{
+				MinItems: 1,
+			},
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.block.GetMinItems()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetNestedBlockGetNestedObject(t *testing.T) {
 	t.Parallel()
 
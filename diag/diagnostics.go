@@ -4,6 +4,9 @@
 package diag
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -85,6 +88,33 @@ func (diags Diagnostics) HasError() bool {
 	return false
 }
 
+// Err returns a Go error joining all the Diagnostic in Diagnostics that are
+// SeverityError, or nil if there are none. Each error contains the diagnostic
+// summary and detail, prefixed by the attribute path if present. Warnings are
+// not included, use the Warnings method to retrieve them separately.
+//
+// This is intended for callers outside of the framework RPC handling which
+// expect a Go error, such as tests or programs embedding the framework.
+func (diags Diagnostics) Err() error {
+	var errs []error
+
+	for _, d := range diags.Errors() {
+		msg := d.Summary()
+
+		if d.Detail() != "" {
+			msg += ": " + d.Detail()
+		}
+
+		if withPath, ok := d.(DiagnosticWithPath); ok {
+			msg = fmt.Sprintf("%s: %s", withPath.Path(), msg)
+		}
+
+		errs = append(errs, errors.New(msg))
+	}
+
+	return errors.Join(errs...)
+}
+
 // ErrorsCount returns the number of Diagnostic in Diagnostics that are SeverityError.
 func (diags Diagnostics) ErrorsCount() int {
 	return len(diags.Errors())
@@ -120,3 +150,23 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// Merge returns a new collection containing all diagnostics of the given
+// collections, concatenated in order. Nil diagnostics are skipped, however
+// duplicate diagnostics are preserved. Use the Diagnostics type Append method
+// to also remove duplicate diagnostics.
+func Merge(in ...Diagnostics) Diagnostics {
+	var result Diagnostics
+
+	for _, diags := range in {
+		for _, diag := range diags {
+			if diag == nil {
+				continue
+			}
+
+			result = append(result, diag)
+		}
+	}
+
+	return result
+}
@@ -650,6 +650,80 @@ func TestDiagnosticsHasError(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected string
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags: nil,
+		},
+		"empty": {
+			diags: diag.Diagnostics{},
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "Warning detail."),
+			},
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected: "Error Summary: Error detail.",
+		},
+		"error-no-detail": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", ""),
+			},
+			expected: "Error Summary",
+		},
+		"attribute-error": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+			expected: "test: Error Summary: Error detail.",
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary 1", "Error detail 1."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary 2", "Error detail 2."),
+			},
+			expected: "Error Summary 1: Error detail 1.\ntest: Error Summary 2: Error detail 2.",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.diags.Err()
+
+			if test.expected == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error: %s", test.expected)
+			}
+
+			if diff := cmp.Diff(test.expected, err.Error()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsErrorsCount(t *testing.T) {
 	t.Parallel()
 
@@ -833,3 +907,83 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       []diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"none": {
+			in:       nil,
+			expected: nil,
+		},
+		"nil": {
+			in:       []diag.Diagnostics{nil, nil},
+			expected: nil,
+		},
+		"nil-diagnostic": {
+			in: []diag.Diagnostics{
+				{
+					nil,
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+		},
+		"ordered": {
+			in: []diag.Diagnostics{
+				{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+					diag.NewWarningDiagnostic("two summary", "two detail"),
+				},
+				nil,
+				{
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+				},
+				{
+					diag.NewWarningDiagnostic("four summary", "four detail"),
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+				diag.NewWarningDiagnostic("four summary", "four detail"),
+			},
+		},
+		"duplicate": {
+			in: []diag.Diagnostics{
+				{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+					diag.NewWarningDiagnostic("two summary", "two detail"),
+				},
+				{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.Merge(tc.in...)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
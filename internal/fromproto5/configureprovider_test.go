@@ -74,6 +74,16 @@ func TestConfigureProviderRequest(t *testing.T) {
 				),
 			},
 		},
+		"config-missing": {
+			input:          &tfprotov5.ConfigureProviderRequest{},
+			providerSchema: testFwSchema,
+			expected: &provider.ConfigureRequest{
+				Config: tfsdk.Config{
+					Raw:    tftypes.NewValue(testProto5Type, nil),
+					Schema: testFwSchema,
+				},
+			},
+		},
 		"config": {
 			input: &tfprotov5.ConfigureProviderRequest{
 				Config: &testProto5DynamicValue,
@@ -5,11 +5,14 @@ package fromproto5
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // DynamicValue returns the fwschemadata.Data for a given
@@ -20,6 +23,11 @@ import (
 // developers from needing to understand Terraform's differences between
 // block and attribute values where blocks are technically never null, but from
 // a developer perspective this distinction introduces unnecessary complexity.
+//
+// Terraform does not transmit value marks, such as sensitive, across the
+// protocol, so the returned data never contains marked values. The sensitivity
+// of a value is determined by the schema instead, such as the Sensitive field
+// of an attribute.
 func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fwschema.Schema, description fwschemadata.DataDescription) (fwschemadata.Data, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -40,7 +48,8 @@ func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fw
 			"An unexpected error was encountered when converting the "+description.String()+" from the protocol type. "+
 				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
 				"Please report this to the provider developer:\n\n"+
-				"Unable to unmarshal DynamicValue: "+err.Error(),
+				"Unable to unmarshal DynamicValue: "+err.Error()+"\n\n"+
+				unmarshalErrorTypes(ctx, proto5, schema, err),
 		)
 
 		return *data, diags
@@ -52,3 +61,49 @@ func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fw
 
 	return *data, diags
 }
+
+// unmarshalErrorTypes returns the expected and actual type details for a
+// DynamicValue unmarshal error. If the error is associated with an attribute
+// path in the schema, the path and the types at that path are returned.
+// Otherwise, the types of the entire data are returned. The actual type is
+// inferred from the encoded data and omitted if it cannot be determined.
+func unmarshalErrorTypes(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fwschema.Schema, err error) string {
+	actualType, actualTypeErr := dynamicValueType(proto5)
+
+	var pathErr tftypes.AttributePathError
+
+	if errors.As(err, &pathErr) && len(pathErr.Path.Steps()) > 0 {
+		attrType, typeErr := schema.TypeAtTerraformPath(ctx, pathErr.Path)
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, pathErr.Path, schema)
+
+		if typeErr == nil && !fwPathDiags.HasError() {
+			result := "Path: " + fwPath.String() + "\nExpected Type: " + attrType.String()
+
+			if actualTypeErr != nil {
+				return result
+			}
+
+			// The inferred type may not contain the path, such as when the
+			// encoded data is missing the attribute.
+			actualPathType, _, err := tftypes.WalkAttributePath(actualType, pathErr.Path)
+
+			if err != nil {
+				return result
+			}
+
+			if actualPathType, ok := actualPathType.(tftypes.Type); ok {
+				result += "\nActual Type: " + actualPathType.String()
+			}
+
+			return result
+		}
+	}
+
+	result := "Expected Type: " + schema.Type().String()
+
+	if actualTypeErr == nil {
+		result += "\nActual Type: " + actualType.String()
+	}
+
+	return result
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ConfigureProviderRequest returns the *fwserver.ConfigureProviderRequest
@@ -29,5 +31,15 @@ func ConfigureProviderRequest(ctx context.Context, proto6 *tfprotov6.ConfigurePr
 		fw.Config = *config
 	}
 
+	// Terraform should always send the provider configuration, however
+	// ensure a missing configuration is a null value with the schema so
+	// provider Configure method Config.Get calls do not panic.
+	if proto6.Config == nil && providerSchema != nil {
+		fw.Config = tfsdk.Config{
+			Raw:    tftypes.NewValue(providerSchema.Type().TerraformType(ctx), nil),
+			Schema: providerSchema,
+		}
+	}
+
 	return fw, diags
 }
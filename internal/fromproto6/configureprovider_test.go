@@ -74,6 +74,16 @@ func TestConfigureProviderRequest(t *testing.T) {
 				),
 			},
 		},
+		"config-missing": {
+			input:          &tfprotov6.ConfigureProviderRequest{},
+			providerSchema: testFwSchema,
+			expected: &provider.ConfigureRequest{
+				Config: tfsdk.Config{
+					Raw:    tftypes.NewValue(testProto6Type, nil),
+					Schema: testFwSchema,
+				},
+			},
+		},
 		"config": {
 			input: &tfprotov6.ConfigureProviderRequest{
 				Config: &testProto6DynamicValue,
@@ -105,7 +105,139 @@ func TestDynamicValue(t *testing.T) {
 					"An unexpected error was encountered when converting the configuration from the protocol type. "+
 						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
 						"Please report this to the provider developer:\n\n"+
-						"Unable to unmarshal DynamicValue: AttributeName(\"test\"): couldn't decode bool: msgpack: invalid code=aa decoding bool",
+						"Unable to unmarshal DynamicValue: AttributeName(\"test\"): couldn't decode bool: msgpack: invalid code=aa decoding bool\n\n"+
+						"Path: test\nExpected Type: basetypes.BoolType\nActual Type: tftypes.String",
+				),
+			},
+		},
+		"unmarshal-error-root": {
+			proto6: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"other": tftypes.String,
+						"test":  tftypes.Bool,
+					},
+				},
+				map[string]tftypes.Value{
+					"other": tftypes.NewValue(tftypes.String, "test-value"),
+					"test":  tftypes.NewValue(tftypes.Bool, true),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.BoolType,
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional: true,
+							Type:     types.BoolType,
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: error decoding object; expected 1 attributes, got 2\n\n"+
+						"Expected Type: types.ObjectType[\"test\":basetypes.BoolType]\n"+
+						"Actual Type: tftypes.Object[\"other\":tftypes.String, \"test\":tftypes.Bool]",
+				),
+			},
+		},
+		"unmarshal-error-root-unknown": {
+			proto6: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"other": tftypes.List{ElementType: tftypes.String},
+						"test":  tftypes.Bool,
+					},
+				},
+				map[string]tftypes.Value{
+					"other": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "test-value"),
+						tftypes.NewValue(tftypes.String, nil),
+					}),
+					"test": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.BoolType,
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional: true,
+							Type:     types.BoolType,
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: error decoding object; expected 1 attributes, got 2\n\n"+
+						"Expected Type: types.ObjectType[\"test\":basetypes.BoolType]\n"+
+						"Actual Type: tftypes.Object[\"other\":tftypes.Tuple[tftypes.String, tftypes.DynamicPseudoType], \"test\":tftypes.DynamicPseudoType]",
+				),
+			},
+		},
+		"unmarshal-error-json": {
+			proto6: &tfprotov6.DynamicValue{
+				JSON: []byte(`{"test": "test-value", "other": [1, true, null]}`),
+			},
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.BoolType, // intentional for testing error
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional: true,
+							Type:     types.BoolType, // intentional for testing error
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test\"): unsupported type string sent as tftypes.Bool\n\n"+
+						"Path: test\nExpected Type: basetypes.BoolType\nActual Type: tftypes.String",
 				),
 			},
 		},
@@ -151,6 +283,53 @@ func TestDynamicValue(t *testing.T) {
 				),
 			},
 		},
+		"attribute-value-sensitive": {
+			// Terraform removes value marks, such as sensitive, before sending
+			// values across the protocol. Sensitivity is always determined from
+			// the schema.
+			proto6: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "test-value"),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional:  true,
+						Sensitive: true,
+						Type:      types.StringType,
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional:  true,
+							Sensitive: true,
+							Type:      types.StringType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		},
 		"block-list-empty": {
 			proto6: DynamicValueMust(tftypes.NewValue(
 				tftypes.Object{
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// dynamicValueType returns the tftypes.Type inferred from the encoded data of
+// a *tfprotov6.DynamicValue, without an expected type. This is only intended
+// for diagnostics when the data cannot be unmarshalled with the schema type.
+//
+// Since the encoded data does not contain type information, maps and objects
+// are always inferred as objects, lists, sets, and tuples are always inferred
+// as tuples, and null and unknown values are inferred as DynamicPseudoType.
+func dynamicValueType(proto6 *tfprotov6.DynamicValue) (tftypes.Type, error) {
+	if proto6 == nil {
+		return nil, fmt.Errorf("missing DynamicValue")
+	}
+
+	if len(proto6.MsgPack) > 0 {
+		return msgPackType(msgpack.NewDecoder(bytes.NewReader(proto6.MsgPack)))
+	}
+
+	if len(proto6.JSON) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(proto6.JSON))
+		decoder.UseNumber()
+
+		var value any
+
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		return jsonType(value), nil
+	}
+
+	return nil, fmt.Errorf("missing DynamicValue data")
+}
+
+// jsonType returns the tftypes.Type inferred from a decoded JSON value.
+func jsonType(value any) tftypes.Type {
+	switch value := value.(type) {
+	case bool:
+		return tftypes.Bool
+	case json.Number:
+		return tftypes.Number
+	case string:
+		return tftypes.String
+	case []any:
+		elementTypes := make([]tftypes.Type, 0, len(value))
+
+		for _, element := range value {
+			elementTypes = append(elementTypes, jsonType(element))
+		}
+
+		return tftypes.Tuple{ElementTypes: elementTypes}
+	case map[string]any:
+		attributeTypes := make(map[string]tftypes.Type, len(value))
+
+		for name, attribute := range value {
+			attributeTypes[name] = jsonType(attribute)
+		}
+
+		return tftypes.Object{AttributeTypes: attributeTypes}
+	default:
+		return tftypes.DynamicPseudoType
+	}
+}
+
+// msgPackType returns the tftypes.Type inferred from the next MessagePack
+// encoded value of the decoder.
+func msgPackType(decoder *msgpack.Decoder) (tftypes.Type, error) {
+	code, err := decoder.PeekCode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case code == msgpcode.False || code == msgpcode.True:
+		return tftypes.Bool, decoder.Skip()
+	case msgpcode.IsFixedNum(code),
+		code >= msgpcode.Float && code <= msgpcode.Int64:
+		return tftypes.Number, decoder.Skip()
+	case msgpcode.IsString(code) || msgpcode.IsBin(code):
+		return tftypes.String, decoder.Skip()
+	case msgpcode.IsFixedArray(code) || code == msgpcode.Array16 || code == msgpcode.Array32:
+		length, err := decoder.DecodeArrayLen()
+
+		if err != nil {
+			return nil, err
+		}
+
+		elementTypes := make([]tftypes.Type, 0, length)
+
+		for i := 0; i < length; i++ {
+			elementType, err := msgPackType(decoder)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes = append(elementTypes, elementType)
+		}
+
+		return tftypes.Tuple{ElementTypes: elementTypes}, nil
+	case msgpcode.IsFixedMap(code) || code == msgpcode.Map16 || code == msgpcode.Map32:
+		length, err := decoder.DecodeMapLen()
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributeTypes := make(map[string]tftypes.Type, length)
+
+		for i := 0; i < length; i++ {
+			name, err := decoder.DecodeString()
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeType, err := msgPackType(decoder)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[name] = attributeType
+		}
+
+		return tftypes.Object{AttributeTypes: attributeTypes}, nil
+	default:
+		// Null values and unknown values, which are encoded as an extension
+		// type, have no inferable type.
+		return tftypes.DynamicPseudoType, decoder.Skip()
+	}
+}
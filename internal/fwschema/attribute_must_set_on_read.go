@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithMustSetOnRead is an optional interface on Attribute which
+// enables verification that the resource Read method refreshed the value of
+// a computed attribute.
+type AttributeWithMustSetOnRead interface {
+	Attribute
+
+	// GetMustSetOnRead should return true if the resource Read method is
+	// expected to always refresh the attribute value. This is named
+	// differently than MustSetOnRead to prevent a conflict with the schema
+	// attribute field name.
+	GetMustSetOnRead() bool
+}
@@ -30,15 +30,46 @@ type AttributeWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - Checks that the given Attribute is not both Required and Computed
+//   - Checks that the given Attribute is not both Required and Optional
+//   - Checks that the given Attribute is at least one of Required, Optional,
+//     or Computed
+//   - If the given Attribute implements the NestedAttributeWithItemBounds
+//     interface, checks that the item bounds are not negative and MinItems is
+//     not greater than MaxItems
 //   - If the given Attribute implements the
 //     AttributeWithValidateImplementation interface, calls the method
 //   - If the given Attribute implements the NestedAttribute interface,
 //     recursively calls this function on nested attributes
+//   - If the given Attribute implements the NestedAttribute interface and is
+//     Computed without Optional, checks that nested attributes are not
+//     Required or Optional since they cannot be configured
 func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, req ValidateImplementationRequest) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	if attribute.IsRequired() && attribute.IsComputed() {
+		diags.Append(AttributeRequiredAndComputedDiag(req.Path))
+	}
+
+	if attribute.IsRequired() && attribute.IsOptional() {
+		diags.Append(AttributeRequiredAndOptionalDiag(req.Path))
+	}
+
+	if !attribute.IsRequired() && !attribute.IsOptional() && !attribute.IsComputed() {
+		diags.Append(AttributeMissingRequiredOptionalComputedDiag(req.Path))
+	}
+
+	if attributeWithItemBounds, ok := attribute.(NestedAttributeWithItemBounds); ok {
+		minItems := attributeWithItemBounds.GetMinItems()
+		maxItems := attributeWithItemBounds.GetMaxItems()
+
+		if !ValidItemBounds(minItems, maxItems) {
+			diags.Append(AttributeInvalidItemBoundsDiag(req.Path, minItems, maxItems))
+		}
+	}
+
 	if attributeWithValidateImplementation, ok := attribute.(AttributeWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -60,6 +91,7 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 	}
 
 	nestingMode := nestedAttribute.GetNestingMode()
+	computedOnly := attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired()
 
 	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
 		var nestedAttributePath path.Path
@@ -88,6 +120,14 @@ func ValidateAttributeImplementation(ctx context.Context, attribute Attribute, r
 			nestedAttributePath = req.Path.AtName(nestedAttributeName)
 		}
 
+		// Nested attributes under a Required or Optional parent can use any
+		// combination, where Required only applies when the parent value is
+		// configured. Nested attributes under a Computed-only parent cannot
+		// be configured.
+		if computedOnly && (nestedAttribute.IsRequired() || nestedAttribute.IsOptional()) {
+			diags.Append(AttributeConfigurableUnderComputedParentDiag(nestedAttributePath, req.Path))
+		}
+
 		nestedReq := ValidateImplementationRequest{
 			Name: nestedAttributeName,
 			Path: nestedAttributePath,
@@ -22,11 +22,14 @@ import (
 // validation.
 //
 // Note that MaxItems and MinItems support, while defined in the Terraform
-// protocol, is intentially not present. Terraform can only perform limited
-// static analysis of blocks and errors generated occur before the provider
-// is called for configuration validation, which means that practitioners do
-// not get all configuration errors at the same time. Provider developers can
-// implement validators to achieve the same validation functionality.
+// protocol, is only available via the optional BlockWithItemBounds interface.
+// Terraform can only perform limited static analysis of blocks and errors
+// generated occur before the provider is called for configuration validation,
+// which means that practitioners do not get all configuration errors at the
+// same time. Provider developers should prefer implementing validators, such
+// as listvalidator.SizeAtLeast and listvalidator.SizeAtMost from
+// terraform-plugin-framework-validators, to achieve the same validation
+// functionality.
 type Block interface {
 	// Implementations should include the tftypes.AttributePathStepper
 	// interface methods for proper path and data handling.
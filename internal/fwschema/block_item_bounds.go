@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// BlockWithItemBounds is an optional interface on Block which enables
+// the minimum and maximum number of configured blocks to be reported to
+// Terraform. A zero value represents no bound. This is only applicable to
+// list and set nesting modes.
+type BlockWithItemBounds interface {
+	Block
+
+	// GetMaxItems should return the maximum number of configured blocks.
+	GetMaxItems() int64
+
+	// GetMinItems should return the minimum number of configured blocks.
+	GetMinItems() int64
+}
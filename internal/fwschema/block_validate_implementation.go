@@ -30,6 +30,9 @@ type BlockWithValidateImplementation interface {
 //
 // This logic currently:
 //   - Checks whether the given AttributeName in the path is a valid identifier
+//   - If the given Block implements the BlockWithItemBounds interface, checks
+//     that the item bounds are not negative and MinItems is not greater than
+//     MaxItems
 //   - If the given Block implements the BlockWithValidateImplementation
 //     interface, calls the method
 //   - Recursively calls this function on nested attributes and blocks
@@ -39,6 +42,15 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 	diags.Append(IsReservedResourceAttributeName(req.Name, req.Path)...)
 	diags.Append(IsValidAttributeName(req.Name, req.Path)...)
 
+	if blockWithItemBounds, ok := block.(BlockWithItemBounds); ok {
+		minItems := blockWithItemBounds.GetMinItems()
+		maxItems := blockWithItemBounds.GetMaxItems()
+
+		if !ValidItemBounds(minItems, maxItems) {
+			diags.Append(BlockInvalidItemBoundsDiag(req.Path, minItems, maxItems))
+		}
+	}
+
 	if blockWithValidateImplementation, ok := block.(BlockWithValidateImplementation); ok {
 		resp := &ValidateImplementationResponse{}
 
@@ -28,6 +28,117 @@ func AttributeMissingAttributeTypesDiag(attributePath path.Path) diag.Diagnostic
 	)
 }
 
+// AttributeConfigurableUnderComputedParentDiag returns a warning diagnostic
+// to provider developers about a Required or Optional nested attribute under
+// a nested attribute which is Computed without Optional. Practitioners cannot
+// configure the parent attribute, so the nested attribute can never be
+// configured.
+func AttributeConfigurableUnderComputedParentDiag(attributePath path.Path, parentPath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewWarningDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is Required or Optional, however the parent attribute %q is Computed without Optional, ", attributePath, parentPath)+
+			"so the attribute can never be configured. Either set only Computed on the attribute, or set Optional on the parent attribute.",
+	)
+}
+
+// AttributeRequiredAndComputedDiag returns an error diagnostic to provider
+// developers about an attribute which is both Required and Computed. Terraform
+// rejects schemas with this combination, as a Required value always comes from
+// configuration and can never be set by the provider.
+func AttributeRequiredAndComputedDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is both Required and Computed. ", attributePath)+
+			"Required attributes must be configured and cannot be set by the provider. Use Optional and Computed instead to allow the provider to set a value when it is not configured.",
+	)
+}
+
+// AttributeRequiredAndOptionalDiag returns an error diagnostic to provider
+// developers about an attribute which is both Required and Optional. Terraform
+// rejects schemas with this combination, as an attribute cannot be both
+// required and optional in configuration.
+func AttributeRequiredAndOptionalDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is both Required and Optional. ", attributePath)+
+			"Set only one of these fields, depending on whether the attribute must be configured.",
+	)
+}
+
+// AttributeMissingRequiredOptionalComputedDiag returns an error diagnostic to
+// provider developers about an attribute which is not Required, Optional, or
+// Computed. Terraform rejects schemas with this combination, as the attribute
+// could neither be configured nor set by the provider.
+func AttributeMissingRequiredOptionalComputedDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is missing the Required, Optional, or Computed field. ", attributePath)+
+			"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+	)
+}
+
+// AttributeInvalidItemBoundsDiag returns an error diagnostic to provider
+// developers about a nested attribute with a negative MinItems or MaxItems, or
+// a MinItems greater than MaxItems. No configuration could satisfy the bounds.
+func AttributeInvalidItemBoundsDiag(attributePath path.Path, minItems int64, maxItems int64) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has invalid item bounds, MinItems: %d, MaxItems: %d. ", attributePath, minItems, maxItems)+
+			"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+	)
+}
+
+// BlockInvalidItemBoundsDiag returns an error diagnostic to provider
+// developers about a block with a negative MinItems or MaxItems, or a MinItems
+// greater than MaxItems. No configuration could satisfy the bounds.
+func BlockInvalidItemBoundsDiag(blockPath path.Path, minItems int64, maxItems int64) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Block Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has invalid item bounds, MinItems: %d, MaxItems: %d. ", blockPath, minItems, maxItems)+
+			"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+	)
+}
+
+// ValidItemBounds returns false if either bound is negative or if minItems is
+// greater than a non-zero maxItems. A zero value represents no bound.
+func ValidItemBounds(minItems int64, maxItems int64) bool {
+	if minItems < 0 || maxItems < 0 {
+		return false
+	}
+
+	return maxItems == 0 || minItems <= maxItems
+}
+
 // AttributeMissingElementTypeDiag returns an error diagnostic to provider
 // developers about missing the ElementType field on an Attribute
 // implementation. This can cause unexpected errors or panics.
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// AttributePlanModifierDescribers returns all plan modifiers of the Attribute,
+// regardless of value type, as planmodifier.Describer in declaration order.
+func AttributePlanModifierDescribers(a fwschema.Attribute) []planmodifier.Describer {
+	var result []planmodifier.Describer
+
+	if a, ok := a.(AttributeWithBoolPlanModifiers); ok {
+		for _, m := range a.BoolPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithDynamicPlanModifiers); ok {
+		for _, m := range a.DynamicPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithFloat64PlanModifiers); ok {
+		for _, m := range a.Float64PlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithInt64PlanModifiers); ok {
+		for _, m := range a.Int64PlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithListPlanModifiers); ok {
+		for _, m := range a.ListPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithMapPlanModifiers); ok {
+		for _, m := range a.MapPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithNumberPlanModifiers); ok {
+		for _, m := range a.NumberPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithObjectPlanModifiers); ok {
+		for _, m := range a.ObjectPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithSetPlanModifiers); ok {
+		for _, m := range a.SetPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if a, ok := a.(AttributeWithStringPlanModifiers); ok {
+		for _, m := range a.StringPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	return result
+}
+
+// AttributeValidatorDescribers returns all validators of the Attribute,
+// regardless of value type, as validator.Describer in declaration order.
+func AttributeValidatorDescribers(a fwschema.Attribute) []validator.Describer {
+	var result []validator.Describer
+
+	if a, ok := a.(AttributeWithBoolValidators); ok {
+		for _, v := range a.BoolValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithDynamicValidators); ok {
+		for _, v := range a.DynamicValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithFloat64Validators); ok {
+		for _, v := range a.Float64Validators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithInt64Validators); ok {
+		for _, v := range a.Int64Validators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithListValidators); ok {
+		for _, v := range a.ListValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithMapValidators); ok {
+		for _, v := range a.MapValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithNumberValidators); ok {
+		for _, v := range a.NumberValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithObjectValidators); ok {
+		for _, v := range a.ObjectValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithSetValidators); ok {
+		for _, v := range a.SetValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if a, ok := a.(AttributeWithStringValidators); ok {
+		for _, v := range a.StringValidators() {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// BlockPlanModifierDescribers returns all plan modifiers of the Block,
+// regardless of value type, as planmodifier.Describer in declaration order.
+func BlockPlanModifierDescribers(b fwschema.Block) []planmodifier.Describer {
+	var result []planmodifier.Describer
+
+	if b, ok := b.(BlockWithListPlanModifiers); ok {
+		for _, m := range b.ListPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if b, ok := b.(BlockWithObjectPlanModifiers); ok {
+		for _, m := range b.ObjectPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	if b, ok := b.(BlockWithSetPlanModifiers); ok {
+		for _, m := range b.SetPlanModifiers() {
+			result = append(result, m)
+		}
+	}
+
+	return result
+}
+
+// BlockValidatorDescribers returns all validators of the Block, regardless of
+// value type, as validator.Describer in declaration order.
+func BlockValidatorDescribers(b fwschema.Block) []validator.Describer {
+	var result []validator.Describer
+
+	if b, ok := b.(BlockWithListValidators); ok {
+		for _, v := range b.ListValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if b, ok := b.(BlockWithObjectValidators); ok {
+		for _, v := range b.ObjectValidators() {
+			result = append(result, v)
+		}
+	}
+
+	if b, ok := b.(BlockWithSetValidators); ok {
+		for _, v := range b.SetValidators() {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
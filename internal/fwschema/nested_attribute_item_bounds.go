@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// NestedAttributeWithItemBounds is an optional interface on NestedAttribute
+// which enables enforcement of the minimum and maximum number of elements in
+// the configuration. A zero value represents no bound. This is only
+// applicable to list, map, and set nesting modes.
+type NestedAttributeWithItemBounds interface {
+	NestedAttribute
+
+	// GetMaxItems should return the maximum number of elements.
+	GetMaxItems() int64
+
+	// GetMinItems should return the minimum number of elements.
+	GetMinItems() int64
+}
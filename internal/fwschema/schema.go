@@ -75,6 +75,63 @@ type Schema interface {
 	TypeAtTerraformPath(context.Context, *tftypes.AttributePath) (attr.Type, error)
 }
 
+// SchemasEqual is a helper function to perform equality testing on two
+// Schema. Attributes and blocks are compared using their Equal methods, which
+// includes types, nesting, and configurability options, while nil and empty
+// attribute or block maps are considered equal. Schema Equal implementations
+// should still compare the concrete types in addition to using this helper.
+func SchemasEqual(a, b Schema) bool {
+	if a.GetDeprecationMessage() != b.GetDeprecationMessage() {
+		return false
+	}
+
+	if a.GetDescription() != b.GetDescription() {
+		return false
+	}
+
+	if a.GetMarkdownDescription() != b.GetMarkdownDescription() {
+		return false
+	}
+
+	if a.GetVersion() != b.GetVersion() {
+		return false
+	}
+
+	if len(a.GetAttributes()) != len(b.GetAttributes()) {
+		return false
+	}
+
+	for name, aAttribute := range a.GetAttributes() {
+		bAttribute, ok := b.GetAttributes()[name]
+
+		if !ok {
+			return false
+		}
+
+		if !aAttribute.Equal(bAttribute) {
+			return false
+		}
+	}
+
+	if len(a.GetBlocks()) != len(b.GetBlocks()) {
+		return false
+	}
+
+	for name, aBlock := range a.GetBlocks() {
+		bBlock, ok := b.GetBlocks()[name]
+
+		if !ok {
+			return false
+		}
+
+		if !aBlock.Equal(bBlock) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // SchemaApplyTerraform5AttributePathStep is a helper function to perform base
 // tftypes.AttributePathStepper handling using the GetAttributes and GetBlocks
 // methods.
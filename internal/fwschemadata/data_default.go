@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -23,6 +24,7 @@ import (
 func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var err error
+	var pending []pendingDefault
 
 	configData := Data{
 		Description:    DataDescriptionConfiguration,
@@ -99,268 +101,334 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 			}
 		}
 
-		switch a := attrAtPath.(type) {
-		case fwschema.AttributeWithBoolDefaultValue:
-			defaultValue := a.BoolDefaultValue()
+		// The default value of this attribute replaces the values of any
+		// nested attributes, so their pending defaults must not be set.
+		if attributeDefault(attrAtPath) != nil {
+			pending = removeNestedPendingDefaults(ctx, pending, fwPath)
+		}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		// Defaults which depend on other planned values are set after all
+		// other defaults, in dependency order.
+		if dependsOn := attributeDefaultDependsOn(ctx, attrAtPath); len(dependsOn) > 0 {
+			pending = append(pending, pendingDefault{
+				attribute:  attrAtPath,
+				dependsOn:  dependsOn,
+				path:       fwPath,
+				tfTypePath: tfTypePath,
+			})
 
-			req := defaults.BoolRequest{
-				Path: fwPath,
-			}
-			resp := defaults.BoolResponse{}
+			return tfTypeValue, nil
+		}
 
-			defaultValue.DefaultBool(ctx, req, &resp)
+		defaultValue, defaultDiags, err := attributeDefaultValue(ctx, attrAtPath, fwPath, tfTypeValue, nil)
 
-			diags.Append(resp.Diagnostics...)
+		diags.Append(defaultDiags...)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		return defaultValue, err
+	})
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/930
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Error Handling Schema Defaults",
+			"An unexpected error occurred while handling schema default values. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		))
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithFloat64DefaultValue:
-			defaultValue := a.Float64DefaultValue()
+		return diags
+	}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+	diags.Append(d.transformPendingDefaults(ctx, pending)...)
 
-			req := defaults.Float64Request{
-				Path: fwPath,
-			}
-			resp := defaults.Float64Response{}
+	return diags
+}
 
-			defaultValue.DefaultFloat64(ctx, req, &resp)
+// attributeDefaultValue returns the default value of the attribute, or the
+// given value if the attribute has no default value or the default value
+// returned an error diagnostic.
+func attributeDefaultValue(ctx context.Context, attrAtPath fwschema.Attribute, fwPath path.Path, tfTypeValue tftypes.Value, dependencyValues []defaults.DependencyValue) (tftypes.Value, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
 
-			diags.Append(resp.Diagnostics...)
+	switch a := attrAtPath.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		defaultValue := a.BoolDefaultValue()
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		req := defaults.BoolRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.BoolResponse{}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithInt64DefaultValue:
-			defaultValue := a.Int64DefaultValue()
+		defaultValue.DefaultBool(ctx, req, &resp)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		diags.Append(resp.Diagnostics...)
 
-			req := defaults.Int64Request{
-				Path: fwPath,
-			}
-			resp := defaults.Int64Response{}
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			defaultValue.DefaultInt64(ctx, req, &resp)
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			diags.Append(resp.Diagnostics...)
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		return planValue, diags, err
+	case fwschema.AttributeWithFloat64DefaultValue:
+		defaultValue := a.Float64DefaultValue()
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithListDefaultValue:
-			defaultValue := a.ListDefaultValue()
+		req := defaults.Float64Request{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.Float64Response{}
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultFloat64(ctx, req, &resp)
 
-			req := defaults.ListRequest{
-				Path: fwPath,
-			}
-			resp := defaults.ListResponse{}
+		diags.Append(resp.Diagnostics...)
 
-			defaultValue.DefaultList(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			diags.Append(resp.Diagnostics...)
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		return planValue, diags, err
+	case fwschema.AttributeWithInt64DefaultValue:
+		defaultValue := a.Int64DefaultValue()
 
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		req := defaults.Int64Request{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.Int64Response{}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithMapDefaultValue:
-			defaultValue := a.MapDefaultValue()
+		defaultValue.DefaultInt64(ctx, req, &resp)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
-			req := defaults.MapRequest{
-				Path: fwPath,
-			}
-			resp := defaults.MapResponse{}
+		diags.Append(resp.Diagnostics...)
 
-			defaultValue.DefaultMap(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			diags.Append(resp.Diagnostics...)
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		return planValue, diags, err
+	case fwschema.AttributeWithListDefaultValue:
+		defaultValue := a.ListDefaultValue()
 
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		req := defaults.ListRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.ListResponse{}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithNumberDefaultValue:
-			defaultValue := a.NumberDefaultValue()
+		defaultValue.DefaultList(ctx, req, &resp)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		diags.Append(resp.Diagnostics...)
 
-			req := defaults.NumberRequest{
-				Path: fwPath,
-			}
-			resp := defaults.NumberResponse{}
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			defaultValue.DefaultNumber(ctx, req, &resp)
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
 
-			diags.Append(resp.Diagnostics...)
+			return tfTypeValue, diags, nil
+		}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithObjectDefaultValue:
-			defaultValue := a.ObjectDefaultValue()
+		return planValue, diags, err
+	case fwschema.AttributeWithMapDefaultValue:
+		defaultValue := a.MapDefaultValue()
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
+		req := defaults.MapRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.MapResponse{}
 
-			req := defaults.ObjectRequest{
-				Path: fwPath,
-			}
-			resp := defaults.ObjectResponse{}
+		defaultValue.DefaultMap(ctx, req, &resp)
 
-			defaultValue.DefaultObject(ctx, req, &resp)
+		diags.Append(resp.Diagnostics...)
 
-			diags.Append(resp.Diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+			return tfTypeValue, diags, nil
+		}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithSetDefaultValue:
-			defaultValue := a.SetDefaultValue()
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			req := defaults.SetRequest{
-				Path: fwPath,
-			}
-			resp := defaults.SetResponse{}
+		return planValue, diags, err
+	case fwschema.AttributeWithNumberDefaultValue:
+		defaultValue := a.NumberDefaultValue()
 
-			defaultValue.DefaultSet(ctx, req, &resp)
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			diags.Append(resp.Diagnostics...)
+		req := defaults.NumberRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.NumberResponse{}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultNumber(ctx, req, &resp)
 
-			if resp.PlanValue.ElementType(ctx) == nil {
-				logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
+		diags.Append(resp.Diagnostics...)
 
-				return tfTypeValue, nil
-			}
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithStringDefaultValue:
-			defaultValue := a.StringDefaultValue()
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		return planValue, diags, err
+	case fwschema.AttributeWithObjectDefaultValue:
+		defaultValue := a.ObjectDefaultValue()
 
-			req := defaults.StringRequest{
-				Path: fwPath,
-			}
-			resp := defaults.StringResponse{}
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			defaultValue.DefaultString(ctx, req, &resp)
+		req := defaults.ObjectRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.ObjectResponse{}
 
-			diags.Append(resp.Diagnostics...)
+		defaultValue.DefaultObject(ctx, req, &resp)
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		diags.Append(resp.Diagnostics...)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
 
-			return resp.PlanValue.ToTerraformValue(ctx)
-		case fwschema.AttributeWithDynamicDefaultValue:
-			defaultValue := a.DynamicDefaultValue()
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
 
-			if defaultValue == nil {
-				return tfTypeValue, nil
-			}
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
 
-			req := defaults.DynamicRequest{
-				Path: fwPath,
-			}
-			resp := defaults.DynamicResponse{}
+		return planValue, diags, err
+	case fwschema.AttributeWithSetDefaultValue:
+		defaultValue := a.SetDefaultValue()
 
-			defaultValue.DefaultDynamic(ctx, req, &resp)
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
 
-			diags.Append(resp.Diagnostics...)
+		req := defaults.SetRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.SetResponse{}
 
-			if resp.Diagnostics.HasError() {
-				return tfTypeValue, nil
-			}
+		defaultValue.DefaultSet(ctx, req, &resp)
 
-			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+		diags.Append(resp.Diagnostics...)
 
-			return resp.PlanValue.ToTerraformValue(ctx)
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
 		}
 
-		return tfTypeValue, nil
-	})
+		if resp.PlanValue.ElementType(ctx) == nil {
+			logging.FrameworkWarn(ctx, "attribute default declared, but returned no value")
 
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/930
-	if err != nil {
-		diags.Append(diag.NewErrorDiagnostic(
-			"Error Handling Schema Defaults",
-			"An unexpected error occurred while handling schema default values. "+
-				"Please report the following to the provider developer:\n\n"+
-				"Error: "+err.Error(),
-		))
+			return tfTypeValue, diags, nil
+		}
+
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
+
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
+
+		return planValue, diags, err
+	case fwschema.AttributeWithStringDefaultValue:
+		defaultValue := a.StringDefaultValue()
+
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
+
+		req := defaults.StringRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.StringResponse{}
+
+		defaultValue.DefaultString(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
+
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
+
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
+
+		return planValue, diags, err
+	case fwschema.AttributeWithDynamicDefaultValue:
+		defaultValue := a.DynamicDefaultValue()
+
+		if defaultValue == nil {
+			return tfTypeValue, diags, nil
+		}
+
+		req := defaults.DynamicRequest{
+			Path:             fwPath,
+			DependencyValues: dependencyValues,
+		}
+		resp := defaults.DynamicResponse{}
+
+		defaultValue.DefaultDynamic(ctx, req, &resp)
+
+		diags.Append(resp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return tfTypeValue, diags, nil
+		}
+
+		logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value", fwPath))
+
+		planValue, err := resp.PlanValue.ToTerraformValue(ctx)
+
+		return planValue, diags, err
 	}
 
-	return diags
+	return tfTypeValue, diags, nil
 }
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// pendingDefault is an attribute default value which depends on the planned
+// values of other attributes.
+type pendingDefault struct {
+	attribute  fwschema.Attribute
+	dependsOn  path.Expressions
+	path       path.Path
+	tfTypePath *tftypes.AttributePath
+}
+
+// attributeDefault returns the default value of the attribute, if any.
+func attributeDefault(a fwschema.Attribute) any {
+	switch a := a.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		if defaultValue := a.BoolDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithDynamicDefaultValue:
+		if defaultValue := a.DynamicDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		if defaultValue := a.Float64DefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		if defaultValue := a.Int64DefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		if defaultValue := a.ListDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		if defaultValue := a.MapDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		if defaultValue := a.NumberDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		if defaultValue := a.ObjectDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		if defaultValue := a.SetDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		if defaultValue := a.StringDefaultValue(); defaultValue != nil {
+			return defaultValue
+		}
+	}
+
+	return nil
+}
+
+// attributeDefaultDependsOn returns the path expressions declared by the
+// attribute default value, if it implements defaults.DependsOn.
+func attributeDefaultDependsOn(ctx context.Context, a fwschema.Attribute) path.Expressions {
+	dependsOn, ok := attributeDefault(a).(defaults.DependsOn)
+
+	if !ok {
+		return nil
+	}
+
+	return dependsOn.DependsOn(ctx)
+}
+
+// removeNestedPendingDefaults returns the pending defaults without those
+// nested under the given path. The default value of an attribute replaces
+// the default values of its nested attributes, so those would otherwise
+// overwrite parts of the parent default value.
+func removeNestedPendingDefaults(ctx context.Context, pending []pendingDefault, parentPath path.Path) []pendingDefault {
+	var result []pendingDefault
+
+	for _, p := range pending {
+		if p.path.Expression().MatchesParent(parentPath) {
+			logging.FrameworkTrace(ctx, fmt.Sprintf("not setting attribute %s default value, parent attribute %s has a default value", p.path, parentPath))
+
+			continue
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// pathsOverlap returns true if the paths are equal or one is nested under the
+// other, in which case the value at one path depends on the value at the
+// other path.
+func pathsOverlap(a, b path.Path) bool {
+	return a.Equal(b) || a.Expression().MatchesParent(b) || b.Expression().MatchesParent(a)
+}
+
+// transformPendingDefaults sets the pending default values after the default
+// values of any pending attributes they depend on. Default values which are
+// part of, or depend on, a dependency cycle are not set. Pending defaults
+// which do not depend on each other are set in the same transformation.
+func (d *Data) transformPendingDefaults(ctx context.Context, pending []pendingDefault) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(pending) == 0 {
+		return diags
+	}
+
+	// Ensure deterministic ordering of independent defaults and diagnostics.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].path.String() < pending[j].path.String()
+	})
+
+	dependencyPaths := make([]path.Paths, len(pending))
+
+	for i, p := range pending {
+		for _, expr := range p.path.Expression().MergeExpressions(p.dependsOn...) {
+			matches, matchesDiags := d.PathMatches(ctx, expr)
+
+			diags.Append(matchesDiags...)
+
+			dependencyPaths[i].Append(matches...)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// dependencies contains the indices of pending defaults each pending
+	// default depends on.
+	dependencies := make([][]int, len(pending))
+
+	for i := range pending {
+		for j, other := range pending {
+			if i == j {
+				continue
+			}
+
+			for _, dependencyPath := range dependencyPaths[i] {
+				if pathsOverlap(dependencyPath, other.path) {
+					dependencies[i] = append(dependencies[i], j)
+
+					break
+				}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var order []int
+	var stack []int
+
+	states := make([]int, len(pending))
+	skipped := make([]bool, len(pending))
+
+	// visit appends the pending default to the order after its dependencies,
+	// returning false if it is part of, or depends on, a dependency cycle.
+	var visit func(i int) bool
+
+	visit = func(i int) bool {
+		switch states[i] {
+		case visited:
+			return !skipped[i]
+		case visiting:
+			var cycle []string
+
+			for k := len(stack) - 1; k >= 0; k-- {
+				cycle = append([]string{pending[stack[k]].path.String()}, cycle...)
+
+				if stack[k] == i {
+					break
+				}
+			}
+
+			cycle = append(cycle, pending[i].path.String())
+
+			diags.AddAttributeError(
+				pending[i].path,
+				"Schema Default Dependency Cycle",
+				"An unexpected error occurred while handling schema default values. "+
+					"The default values of the following attributes depend on each other, so they cannot be set. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					"Cycle: "+strings.Join(cycle, " -> "),
+			)
+
+			return false
+		}
+
+		states[i] = visiting
+		stack = append(stack, i)
+
+		ok := true
+
+		for _, j := range dependencies[i] {
+			if !visit(j) {
+				ok = false
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		states[i] = visited
+
+		if !ok {
+			skipped[i] = true
+
+			return false
+		}
+
+		order = append(order, i)
+
+		return true
+	}
+
+	for i := range pending {
+		visit(i)
+	}
+
+	// levels groups the ordered pending defaults so each group only depends
+	// on the pending defaults of previous groups.
+	var levels [][]int
+
+	level := make([]int, len(pending))
+
+	for _, i := range order {
+		for _, j := range dependencies[i] {
+			if level[j] >= level[i] {
+				level[i] = level[j] + 1
+			}
+		}
+
+		if level[i] == len(levels) {
+			levels = append(levels, nil)
+		}
+
+		levels[level[i]] = append(levels[level[i]], i)
+	}
+
+	for _, indices := range levels {
+		dependencyValues := make([][]defaults.DependencyValue, len(indices))
+
+		for k, i := range indices {
+			for _, dependencyPath := range dependencyPaths[i] {
+				value, valueDiags := d.ValueAtPath(ctx, dependencyPath)
+
+				diags.Append(valueDiags...)
+
+				if valueDiags.HasError() {
+					continue
+				}
+
+				dependencyValues[k] = append(dependencyValues[k], defaults.DependencyValue{
+					Path:  dependencyPath,
+					Value: value,
+				})
+			}
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		found := make([]bool, len(indices))
+
+		var err error
+
+		d.TerraformValue, err = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+			for k, i := range indices {
+				p := pending[i]
+
+				if !tfTypePath.Equal(p.tfTypePath) {
+					continue
+				}
+
+				found[k] = true
+
+				logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s default value with dependencies", p.path))
+
+				defaultValue, defaultDiags, err := attributeDefaultValue(ctx, p.attribute, p.path, tfTypeValue, dependencyValues[k])
+
+				diags.Append(defaultDiags...)
+
+				return defaultValue, err
+			}
+
+			return tfTypeValue, nil
+		})
+
+		if err != nil {
+			diags.AddError(
+				"Error Handling Schema Defaults",
+				"An unexpected error occurred while handling schema default values. "+
+					"Please report the following to the provider developer:\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return diags
+		}
+
+		for k, i := range indices {
+			if found[k] {
+				continue
+			}
+
+			diags.AddAttributeError(
+				pending[i].path,
+				"Error Handling Schema Defaults",
+				"An unexpected error occurred while handling schema default values. "+
+					"The attribute default value depends on other attributes, however the attribute was no longer found "+
+					"after setting other default values, so its default value could not be set. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+		}
+	}
+
+	return diags
+}
@@ -4,18 +4,22 @@
 package fwschemadata_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -9693,3 +9697,486 @@ func TestDataDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestDataDefault_sensitiveLogging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"not_sensitive": testschema.AttributeWithStringDefaultValue{
+				Computed: true,
+				Default:  stringdefault.StaticString("test-not-sensitive-value"),
+			},
+			"sensitive": testschema.AttributeWithStringDefaultValue{
+				Computed:  true,
+				Default:   stringdefault.StaticString("test-sensitive-value"),
+				Sensitive: true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"not_sensitive": tftypes.String,
+			"sensitive":     tftypes.String,
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"not_sensitive": tftypes.NewValue(tftypes.String, nil),
+		"sensitive":     tftypes.NewValue(tftypes.String, nil),
+	})
+
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         testSchema,
+		TerraformValue: testValue,
+	}
+
+	diags := data.TransformDefaults(ctx, testValue)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	if !strings.Contains(output.String(), "setting attribute not_sensitive to default value") {
+		t.Errorf("expected not sensitive default path in logs, got: %s", output.String())
+	}
+
+	if strings.Contains(output.String(), "test-not-sensitive-value") {
+		t.Errorf("unexpected not sensitive default value in logs: %s", output.String())
+	}
+
+	if strings.Contains(output.String(), "test-sensitive-value") {
+		t.Errorf("unexpected sensitive default value in logs: %s", output.String())
+	}
+}
+
+func TestDataDefault_dependsOn(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"attr_a": tftypes.String,
+			"attr_b": tftypes.String,
+			"attr_c": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		attributes    map[string]fwschema.Attribute
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"dependency": {
+			attributes: map[string]fwschema.Attribute{
+				// attr_a is walked before attr_b, so its default value must be
+				// deferred until after the attr_b default value.
+				"attr_a": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  testSuffixDefault(path.MatchRoot("attr_b"), "-a"),
+				},
+				"attr_b": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("b"),
+				},
+				"attr_c": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_a": tftypes.NewValue(tftypes.String, "b-a"),
+				"attr_b": tftypes.NewValue(tftypes.String, "b"),
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"dependency-chain": {
+			attributes: map[string]fwschema.Attribute{
+				"attr_a": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  testSuffixDefault(path.MatchRelative().AtParent().AtName("attr_b"), "-a"),
+				},
+				"attr_b": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  testSuffixDefault(path.MatchRoot("attr_c"), "-b"),
+				},
+				"attr_c": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("c"),
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_a": tftypes.NewValue(tftypes.String, "c-b-a"),
+				"attr_b": tftypes.NewValue(tftypes.String, "c-b"),
+				"attr_c": tftypes.NewValue(tftypes.String, "c"),
+			}),
+		},
+		"cycle": {
+			attributes: map[string]fwschema.Attribute{
+				"attr_a": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  testSuffixDefault(path.MatchRoot("attr_b"), "-a"),
+				},
+				"attr_b": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  testSuffixDefault(path.MatchRoot("attr_a"), "-b"),
+				},
+				"attr_c": testschema.AttributeWithStringDefaultValue{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("c"),
+				},
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_a": tftypes.NewValue(tftypes.String, nil),
+				"attr_b": tftypes.NewValue(tftypes.String, nil),
+				"attr_c": tftypes.NewValue(tftypes.String, "c"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("attr_a"),
+					"Schema Default Dependency Cycle",
+					"An unexpected error occurred while handling schema default values. "+
+						"The default values of the following attributes depend on each other, so they cannot be set. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Cycle: attr_a -> attr_b -> attr_a",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			nullValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_a": tftypes.NewValue(tftypes.String, nil),
+				"attr_b": tftypes.NewValue(tftypes.String, nil),
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+			})
+
+			data := &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema: testschema.Schema{
+					Attributes: testCase.attributes,
+				},
+				TerraformValue: nullValue,
+			}
+
+			diags := data.TransformDefaults(context.Background(), nullValue)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataDefault_dependsOnNested(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"attr_a": tftypes.String,
+			"attr_b": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"attr_c":     tftypes.String,
+			"parent":     testObjectType,
+			"set_nested": tftypes.Set{ElementType: testObjectType},
+		},
+	}
+
+	testObjectAttrTypes := map[string]attr.Type{
+		"attr_a": types.StringType,
+		"attr_b": types.StringType,
+	}
+
+	testNullObject := tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+		"attr_a": tftypes.NewValue(tftypes.String, nil),
+		"attr_b": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		attributes    map[string]fwschema.Attribute
+		config        tftypes.Value
+		plan          tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nested-dependency": {
+			attributes: map[string]fwschema.Attribute{
+				"attr_c": schema.StringAttribute{
+					Optional: true,
+				},
+				"parent": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"attr_a": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  testSuffixDefault(path.MatchRelative().AtParent().AtName("attr_b"), "-a"),
+						},
+						"attr_b": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("b"),
+						},
+					},
+					Optional: true,
+				},
+				"set_nested": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"attr_a": schema.StringAttribute{
+								Optional: true,
+							},
+							"attr_b": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c":     tftypes.NewValue(tftypes.String, nil),
+				"parent":     testNullObject,
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+			plan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c":     tftypes.NewValue(tftypes.String, nil),
+				"parent":     testNullObject,
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+				"parent": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"attr_a": tftypes.NewValue(tftypes.String, "b-a"),
+					"attr_b": tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+		},
+		"parent-default": {
+			attributes: map[string]fwschema.Attribute{
+				"attr_c": schema.StringAttribute{
+					Optional: true,
+				},
+				// The parent default value replaces the nested default
+				// values, including those which depend on other attributes.
+				"parent": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"attr_a": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  testSuffixDefault(path.MatchRelative().AtParent().AtName("attr_b"), "-a"),
+						},
+						"attr_b": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString("b"),
+						},
+					},
+					Optional: true,
+					Computed: true,
+					Default: objectdefault.StaticValue(
+						types.ObjectValueMust(
+							testObjectAttrTypes,
+							map[string]attr.Value{
+								"attr_a": types.StringValue("parent-a"),
+								"attr_b": types.StringValue("parent-b"),
+							},
+						),
+					),
+				},
+				"set_nested": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"attr_a": schema.StringAttribute{
+								Optional: true,
+							},
+							"attr_b": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c":     tftypes.NewValue(tftypes.String, nil),
+				"parent":     tftypes.NewValue(testObjectType, nil),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+			// The parent value is known, such as from the prior state, so
+			// the nested attributes are walked.
+			plan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c":     tftypes.NewValue(tftypes.String, nil),
+				"parent":     testNullObject,
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+				"parent": tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"attr_a": tftypes.NewValue(tftypes.String, "parent-a"),
+					"attr_b": tftypes.NewValue(tftypes.String, "parent-b"),
+				}),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil),
+			}),
+		},
+		"set-element-path-missing": {
+			attributes: map[string]fwschema.Attribute{
+				"attr_c": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("c"),
+				},
+				"parent": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"attr_a": schema.StringAttribute{
+							Optional: true,
+						},
+						"attr_b": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Optional: true,
+				},
+				// The attr_b default value changes the set element value, so
+				// the attr_a default value can no longer be set at its path.
+				"set_nested": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"attr_a": schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+								Default:  testSuffixDefault(path.MatchRoot("attr_c"), "-a"),
+							},
+							"attr_b": schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+								Default:  stringdefault.StaticString("b"),
+							},
+						},
+					},
+					Optional: true,
+				},
+			},
+			config: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+				"parent": tftypes.NewValue(testObjectType, nil),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, []tftypes.Value{
+					testNullObject,
+				}),
+			}),
+			plan: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c": tftypes.NewValue(tftypes.String, nil),
+				"parent": tftypes.NewValue(testObjectType, nil),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, []tftypes.Value{
+					testNullObject,
+				}),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"attr_c": tftypes.NewValue(tftypes.String, "c"),
+				"parent": tftypes.NewValue(testObjectType, nil),
+				"set_nested": tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, []tftypes.Value{
+					tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+						"attr_a": tftypes.NewValue(tftypes.String, nil),
+						"attr_b": tftypes.NewValue(tftypes.String, "b"),
+					}),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set_nested").AtSetValue(
+						types.ObjectValueMust(
+							testObjectAttrTypes,
+							map[string]attr.Value{
+								"attr_a": types.StringNull(),
+								"attr_b": types.StringNull(),
+							},
+						),
+					).AtName("attr_a"),
+					"Error Handling Schema Defaults",
+					"An unexpected error occurred while handling schema default values. "+
+						"The attribute default value depends on other attributes, however the attribute was no longer found "+
+						"after setting other default values, so its default value could not be set. "+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema: testschema.Schema{
+					Attributes: testCase.attributes,
+				},
+				TerraformValue: testCase.plan,
+			}
+
+			diags := data.TransformDefaults(context.Background(), testCase.config)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testSuffixDefault returns a default value which appends a suffix to the
+// planned value of the given dependency.
+func testSuffixDefault(dependency path.Expression, suffix string) testdefaults.String {
+	return testdefaults.String{
+		DependsOnMethod: func(_ context.Context) path.Expressions {
+			return path.Expressions{dependency}
+		},
+		DefaultStringMethod: func(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+			if len(req.DependencyValues) != 1 {
+				resp.Diagnostics.AddError("unexpected req.DependencyValues", fmt.Sprintf("expected 1 value, got: %v", req.DependencyValues))
+
+				return
+			}
+
+			dependencyValue, ok := req.DependencyValues[0].Value.(types.String)
+
+			if !ok || dependencyValue.IsNull() {
+				resp.Diagnostics.AddError("unexpected req.DependencyValues", fmt.Sprintf("expected known string, got: %s", req.DependencyValues[0].Value))
+
+				return
+			}
+
+			resp.PlanValue = types.StringValue(dependencyValue.ValueString() + suffix)
+		},
+	}
+}
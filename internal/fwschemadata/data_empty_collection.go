@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// EmptyListAtPath returns a known, empty list value using the type of the
+// schema attribute or block at the given path. Custom types return their
+// associated custom value type.
+func (d Data) EmptyListAtPath(ctx context.Context, p path.Path) (basetypes.ListValuable, diag.Diagnostics) {
+	attrType, diags := d.Schema.TypeAtPath(ctx, p)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := attrType.(basetypes.ListTypable); !ok {
+		diags.Append(d.emptyCollectionTypeError(p, "list", attrType))
+
+		return nil, diags
+	}
+
+	value, valueDiags := d.emptyCollectionValue(ctx, p, "list", attrType, []tftypes.Value{})
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	listValuable, ok := value.(basetypes.ListValuable)
+
+	if !ok {
+		diags.Append(d.emptyCollectionValueTypeError(p, "list", value))
+
+		return nil, diags
+	}
+
+	return listValuable, diags
+}
+
+// EmptyMapAtPath returns a known, empty map value using the type of the
+// schema attribute at the given path. Custom types return their associated
+// custom value type.
+func (d Data) EmptyMapAtPath(ctx context.Context, p path.Path) (basetypes.MapValuable, diag.Diagnostics) {
+	attrType, diags := d.Schema.TypeAtPath(ctx, p)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := attrType.(basetypes.MapTypable); !ok {
+		diags.Append(d.emptyCollectionTypeError(p, "map", attrType))
+
+		return nil, diags
+	}
+
+	value, valueDiags := d.emptyCollectionValue(ctx, p, "map", attrType, map[string]tftypes.Value{})
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	mapValuable, ok := value.(basetypes.MapValuable)
+
+	if !ok {
+		diags.Append(d.emptyCollectionValueTypeError(p, "map", value))
+
+		return nil, diags
+	}
+
+	return mapValuable, diags
+}
+
+// EmptySetAtPath returns a known, empty set value using the type of the
+// schema attribute or block at the given path. Custom types return their
+// associated custom value type.
+func (d Data) EmptySetAtPath(ctx context.Context, p path.Path) (basetypes.SetValuable, diag.Diagnostics) {
+	attrType, diags := d.Schema.TypeAtPath(ctx, p)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := attrType.(basetypes.SetTypable); !ok {
+		diags.Append(d.emptyCollectionTypeError(p, "set", attrType))
+
+		return nil, diags
+	}
+
+	value, valueDiags := d.emptyCollectionValue(ctx, p, "set", attrType, []tftypes.Value{})
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	setValuable, ok := value.(basetypes.SetValuable)
+
+	if !ok {
+		diags.Append(d.emptyCollectionValueTypeError(p, "set", value))
+
+		return nil, diags
+	}
+
+	return setValuable, diags
+}
+
+// emptyCollectionValue creates the empty collection value through the schema
+// type, so any element type and custom value type conversion is handled by
+// the type itself rather than assumed to be a framework base type.
+func (d Data) emptyCollectionValue(ctx context.Context, p path.Path, collection string, attrType attr.Type, elements interface{}) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), elements))
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			d.Description.Title()+" Value Creation Error",
+			fmt.Sprintf("An unexpected error was encountered trying to create an empty %s value for the %s. ", collection, d.Description)+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// emptyCollectionTypeError returns an error diagnostic for when the schema type
+// at a path is not the expected collection type.
+func (d Data) emptyCollectionTypeError(p path.Path, collection string, attrType attr.Type) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		d.Description.Title()+" Value Creation Error",
+		fmt.Sprintf("An unexpected error was encountered trying to create an empty %s value for the %s. ", collection, d.Description)+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Expected a %s type at the path, got: %s", collection, attrType),
+	)
+}
+
+// emptyCollectionValueTypeError returns an error diagnostic for when the
+// schema type at a path creates a value that is not the expected collection
+// value type.
+func (d Data) emptyCollectionValueTypeError(p path.Path, collection string, value attr.Value) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		d.Description.Title()+" Value Creation Error",
+		fmt.Sprintf("An unexpected error was encountered trying to create an empty %s value for the %s. ", collection, d.Description)+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Expected a %s value type from the type at the path, got: %T", collection, value),
+	)
+}
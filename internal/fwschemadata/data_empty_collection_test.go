@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDataEmptyListAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"string": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Required: true,
+			},
+			"list_custom": testschema.Attribute{
+				Type: testtypes.ListTypeWithSemanticEquals{
+					ListType: types.ListType{ElemType: types.StringType},
+				},
+				Required: true,
+			},
+			"list_nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_list": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.Int64Type},
+							Required: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			"list_block": testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_string": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+				NestingMode: fwschema.BlockNestingModeList,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path          path.Path
+		expected      basetypes.ListValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"list": {
+			path:     path.Root("list"),
+			expected: types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"list-custom": {
+			path: path.Root("list_custom"),
+			expected: testtypes.ListValueWithSemanticEquals{
+				ListValue: types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+		},
+		"list-nested": {
+			path: path.Root("list_nested"),
+			expected: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"nested_list": types.ListType{ElemType: types.Int64Type},
+					},
+				},
+				[]attr.Value{},
+			),
+		},
+		"list-nested-element-attribute": {
+			path:     path.Root("list_nested").AtListIndex(0).AtName("nested_list"),
+			expected: types.ListValueMust(types.Int64Type, []attr.Value{}),
+		},
+		"list-block": {
+			path: path.Root("list_block"),
+			expected: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"nested_string": types.StringType,
+					},
+				},
+				[]attr.Value{},
+			),
+		},
+		"not-list": {
+			path: path.Root("string"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string"),
+					"State Value Creation Error",
+					"An unexpected error was encountered trying to create an empty list value for the state. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected a list type at the path, got: basetypes.StringType",
+				),
+			},
+		},
+		"invalid-path": {
+			path: path.Root("missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Invalid Schema Path",
+					"When attempting to get the framework type associated with a schema path, an unexpected error was returned. "+
+						"This is always an issue with the provider. Please report this to the provider developers.\n\n"+
+						"Path: missing\n"+
+						"Original Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+			}
+
+			got, diags := data.EmptyListAtPath(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// The value is not meaningful when there are error diagnostics.
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataEmptyMapAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Required: true,
+			},
+			"map": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Required: true,
+			},
+			"map_custom": testschema.Attribute{
+				Type: testtypes.MapTypeWithSemanticEquals{
+					MapType: types.MapType{ElemType: types.StringType},
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path          path.Path
+		expected      basetypes.MapValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"map": {
+			path:     path.Root("map"),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		},
+		"map-custom": {
+			path: path.Root("map_custom"),
+			expected: testtypes.MapValueWithSemanticEquals{
+				MapValue: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			},
+		},
+		"not-map": {
+			path: path.Root("list"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Plan Value Creation Error",
+					"An unexpected error was encountered trying to create an empty map value for the plan. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected a map type at the path, got: types.ListType[basetypes.StringType]",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+			}
+
+			got, diags := data.EmptyMapAtPath(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// The value is not meaningful when there are error diagnostics.
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataEmptySetAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"map": testschema.Attribute{
+				Type:     types.MapType{ElemType: types.StringType},
+				Required: true,
+			},
+			"set": testschema.Attribute{
+				Type:     types.SetType{ElemType: types.BoolType},
+				Required: true,
+			},
+			"set_custom": testschema.Attribute{
+				Type: testtypes.SetTypeWithSemanticEquals{
+					SetType: types.SetType{ElemType: types.BoolType},
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		path          path.Path
+		expected      basetypes.SetValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"set": {
+			path:     path.Root("set"),
+			expected: types.SetValueMust(types.BoolType, []attr.Value{}),
+		},
+		"set-custom": {
+			path: path.Root("set_custom"),
+			expected: testtypes.SetValueWithSemanticEquals{
+				SetValue: types.SetValueMust(types.BoolType, []attr.Value{}),
+			},
+		},
+		"not-set": {
+			path: path.Root("map"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("map"),
+					"State Value Creation Error",
+					"An unexpected error was encountered trying to create an empty set value for the state. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected a set type at the path, got: types.MapType[basetypes.StringType]",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+			}
+
+			got, diags := data.EmptySetAtPath(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			// The value is not meaningful when there are error diagnostics.
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5856,6 +5856,50 @@ func TestDataGetAtPath(t *testing.T) {
 				},
 			)),
 		},
+		"SingleNestedAttributes-nested-types.String-null-parent": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"object": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Optional: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"object": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"object": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+							nil,
+						),
+					},
+				),
+			},
+			path:     path.Root("object").AtName("nested_string"),
+			target:   new(types.String),
+			expected: pointer(types.StringNull()),
+		},
 		"SingleNestedAttributes-types.Object-unknown": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +34,12 @@ func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
 		return diags
 	}
 
+	diags.Append(d.unknownNonComputedDiags(ctx, tftypes.NewAttributePath(), tfValue)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
 	d.TerraformValue = tfValue
 
 	return diags
@@ -72,6 +72,12 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		return diags
 	}
 
+	diags.Append(d.unknownNonComputedDiags(ctx, tftypesPath, tfVal)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
 	switch t := newVal.(type) {
 	case xattr.ValidateableAttribute:
 		resp := xattr.ValidateAttributeResponse{}
@@ -32,6 +32,309 @@ func TestDataSetAtPath(t *testing.T) {
 	}
 
 	testCases := map[string]testCase{
+		"state-unknown-required": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name"),
+			val:  types.StringUnknown(),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Cannot write an unknown value to an attribute which is not computed. "+
+						"Only computed attributes can have unknown values in the plan, which must be set to known values after apply.",
+				),
+			},
+		},
+		"state-unknown-computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+			path: path.Root("name"),
+			val:  types.StringUnknown(),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"state-unknown-list-element-required": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, map[string]tftypes.Value{
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "oldvalue"),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags").AtListIndex(0),
+			val:  types.StringUnknown(),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtListIndex(0),
+					"State Write Error",
+					"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Cannot write an unknown value to an attribute which is not computed. "+
+						"Only computed attributes can have unknown values in the plan, which must be set to known values after apply.",
+				),
+			},
+		},
+		"state-unknown-list-element-computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				}, map[string]tftypes.Value{
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "oldvalue"),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.ListType{ElemType: types.StringType},
+							Computed: true,
+						},
+					},
+				},
+			},
+			path: path.Root("tags").AtListIndex(0),
+			val:  types.StringUnknown(),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}),
+		},
+		"state-unknown-nested-attribute-computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"id":   tftypes.String,
+								"name": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"id":   tftypes.String,
+							"name": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"id":   tftypes.NewValue(tftypes.String, "oldid"),
+						"name": tftypes.NewValue(tftypes.String, "oldname"),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Computed: true,
+									},
+									"name": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("nested"),
+			val: types.ObjectValueMust(
+				map[string]attr.Type{
+					"id":   types.StringType,
+					"name": types.StringType,
+				},
+				map[string]attr.Value{
+					"id":   types.StringUnknown(),
+					"name": types.StringValue("newname"),
+				},
+			),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"id":   tftypes.String,
+							"name": tftypes.String,
+						},
+					},
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"name": tftypes.NewValue(tftypes.String, "newname"),
+				}),
+			}),
+		},
+		"state-unknown-nested-attribute-required": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"id":   tftypes.String,
+								"name": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"id":   tftypes.String,
+							"name": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"id":   tftypes.NewValue(tftypes.String, "oldid"),
+						"name": tftypes.NewValue(tftypes.String, "oldname"),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"id": testschema.Attribute{
+										Type:     types.StringType,
+										Computed: true,
+									},
+									"name": testschema.Attribute{
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			path: path.Root("nested"),
+			val: types.ObjectValueMust(
+				map[string]attr.Type{
+					"id":   types.StringType,
+					"name": types.StringType,
+				},
+				map[string]attr.Value{
+					"id":   types.StringValue("newid"),
+					"name": types.StringUnknown(),
+				},
+			),
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"id":   tftypes.String,
+							"name": tftypes.String,
+						},
+					},
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "oldid"),
+					"name": tftypes.NewValue(tftypes.String, "oldname"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("nested").AtName("name"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Cannot write an unknown value to an attribute which is not computed. "+
+						"Only computed attributes can have unknown values in the plan, which must be set to known values after apply.",
+				),
+			},
+		},
 		"add-List-Element-append": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
@@ -761,6 +1064,43 @@ func TestDataSetAtPath(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
 			}),
 		},
+		"invalid-path": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("not-test"),
+			val:  "newvalue",
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not-test"),
+					"Data Write Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not-test\") still remains in the path: could not find attribute or block \"not-test\" in schema",
+				),
+			},
+		},
 		"overwrite-Bool": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
@@ -1573,7 +1913,9 @@ func TestDataSetAtPath(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty(),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nexpected tftypes.Object[\"test\":tftypes.Bool], got tftypes.Bool",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: \nExpected Type: types.ObjectType[\"test\":basetypes.BoolType]\nGiven Go Type: bool",
 				),
 			},
 		},
@@ -2365,6 +2707,123 @@ func TestDataSetAtPath(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 		},
+		"write-Int64-string-type-mismatch": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.Number,
+						"other": tftypes.String,
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.Int64Type,
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			val:  "1",
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.Number,
+					"other": tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: test\nExpected Type: basetypes.Int64Type\nGiven Go Type: string",
+				),
+			},
+		},
+		"write-Number-bool-type-mismatch": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.Number,
+						"other": tftypes.String,
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.NumberType,
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			val:  true,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.Number,
+					"other": tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: test\nExpected Type: basetypes.NumberType\nGiven Go Type: bool",
+				),
+			},
+		},
+		"write-String-int-type-mismatch": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":  tftypes.String,
+						"other": tftypes.String,
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			val:  1,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test":  tftypes.String,
+					"other": tftypes.String,
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: test\nExpected Type: basetypes.StringType\nGiven Go Type: int",
+				),
+			},
+		},
 		"write-Object": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
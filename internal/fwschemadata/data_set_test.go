@@ -61,6 +61,111 @@ func TestDataSet(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
 		},
+		"write-state-unknown-computed": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Name types.String `tfsdk:"name"`
+			}{
+				Name: types.StringUnknown(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"write-state-unknown-required": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Name types.String `tfsdk:"name"`
+			}{
+				Name: types.StringUnknown(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: Cannot write an unknown value to an attribute which is not computed. "+
+						"Only computed attributes can have unknown values in the plan, which must be set to known values after apply.",
+				),
+			},
+		},
+		"write-plan-unknown-required": {
+			data: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Name types.String `tfsdk:"name"`
+			}{
+				Name: types.StringUnknown(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
 		"write-dynamic": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
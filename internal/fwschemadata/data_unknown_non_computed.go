@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// unknownNonComputedDiags returns an error diagnostic for each unknown value
+// within the given value, which is being written at the given path, that
+// belongs to an attribute which is not computed. This only applies to state
+// data, since Terraform only allows computed attributes to be unknown after
+// apply and unknown values are never valid in state.
+func (d Data) unknownNonComputedDiags(ctx context.Context, tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Description != DataDescriptionState || tfTypeValue.IsFullyKnown() {
+		return diags
+	}
+
+	_ = tftypes.Walk(tfTypeValue, func(relativePath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if value.IsFullyKnown() {
+			return false, nil
+		}
+
+		steps := append(tfTypePath.Steps(), relativePath.Steps()...)
+		valuePath := tftypes.NewAttributePathWithSteps(steps)
+		attribute, err := d.nearestAttributeAtTerraformPath(ctx, valuePath)
+
+		// The root object and blocks are not attributes. Continue walking
+		// as no attribute information is available for the current path.
+		if err != nil {
+			return true, nil
+		}
+
+		// Nested attribute objects may contain computed attributes, so only
+		// unknown nested attribute values themselves are checked here.
+		if _, ok := attribute.(fwschema.NestedAttribute); ok && value.IsKnown() {
+			return true, nil
+		}
+
+		if attribute.IsComputed() {
+			return false, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, valuePath, d.Schema)
+
+		diags.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return false, nil
+		}
+
+		diags.AddAttributeError(
+			fwPath,
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write an attribute to the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Error: Cannot write an unknown value to an attribute which is not computed. "+
+				"Only computed attributes can have unknown values in the plan, which must be set to known values after apply.",
+		)
+
+		return false, nil
+	})
+
+	return diags
+}
+
+// nearestAttributeAtTerraformPath returns the attribute at the given path or,
+// if the path is an element or attribute within an attribute without schema
+// information, such as a list element or an object attribute, the nearest
+// parent attribute.
+func (d Data) nearestAttributeAtTerraformPath(ctx context.Context, tfTypePath *tftypes.AttributePath) (fwschema.Attribute, error) {
+	for {
+		attribute, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err == nil {
+			return attribute, nil
+		}
+
+		if !errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) && !errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+			return nil, err
+		}
+
+		if len(tfTypePath.Steps()) == 0 {
+			return nil, err
+		}
+
+		tfTypePath = tfTypePath.WithoutLastStep()
+	}
+}
@@ -30,7 +30,7 @@ func ValueSemanticEqualityFloat64(ctx context.Context, req ValueSemanticEquality
 		ctx,
 		"Calling provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -40,7 +40,7 @@ func ValueSemanticEqualityFloat64(ctx context.Context, req ValueSemanticEquality
 		ctx,
 		"Called provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
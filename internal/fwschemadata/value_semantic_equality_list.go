@@ -43,7 +43,7 @@ func ValueSemanticEqualityList(ctx context.Context, req ValueSemanticEqualityReq
 		ctx,
 		"Calling provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -53,7 +53,7 @@ func ValueSemanticEqualityList(ctx context.Context, req ValueSemanticEqualityReq
 		ctx,
 		"Called provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -43,7 +43,7 @@ func ValueSemanticEqualityMap(ctx context.Context, req ValueSemanticEqualityRequ
 		ctx,
 		"Calling provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -53,7 +53,7 @@ func ValueSemanticEqualityMap(ctx context.Context, req ValueSemanticEqualityRequ
 		ctx,
 		"Called provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
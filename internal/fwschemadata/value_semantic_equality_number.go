@@ -30,7 +30,7 @@ func ValueSemanticEqualityNumber(ctx context.Context, req ValueSemanticEqualityR
 		ctx,
 		"Calling provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -40,7 +40,7 @@ func ValueSemanticEqualityNumber(ctx context.Context, req ValueSemanticEqualityR
 		ctx,
 		"Called provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
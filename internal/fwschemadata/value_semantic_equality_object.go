@@ -43,7 +43,7 @@ func ValueSemanticEqualityObject(ctx context.Context, req ValueSemanticEqualityR
 		ctx,
 		"Calling provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -53,7 +53,7 @@ func ValueSemanticEqualityObject(ctx context.Context, req ValueSemanticEqualityR
 		ctx,
 		"Called provider defined type-based SemanticEquals",
 		map[string]interface{}{
-			logging.KeyValueType: proposedNewValuable.String(),
+			logging.KeyValueType: logging.ValueType(proposedNewValuable),
 		},
 	)
 
@@ -4,12 +4,17 @@
 package fwschemadata_test
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -125,3 +130,51 @@ func TestValueSemanticEqualityString(t *testing.T) {
 		})
 	}
 }
+
+func TestValueSemanticEqualityString_logging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	req := fwschemadata.ValueSemanticEqualityRequest{
+		Path: path.Root("test"),
+		PriorValue: testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue("test-prior-value"),
+			SemanticEquals: true,
+		},
+		ProposedNewValue: testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue("test-new-value"),
+			SemanticEquals: true,
+		},
+	}
+	resp := &fwschemadata.ValueSemanticEqualityResponse{
+		NewValue: req.ProposedNewValue,
+	}
+
+	fwschemadata.ValueSemanticEqualityString(ctx, req, resp)
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	if len(entries) == 0 {
+		t.Fatal("expected log entries, got none")
+	}
+
+	for _, entry := range entries {
+		if got, ok := entry[logging.KeyValueType]; ok && got != "testtypes.StringValueWithSemanticEquals" {
+			t.Errorf("unexpected %s log field value: %s", logging.KeyValueType, got)
+		}
+	}
+
+	for _, value := range []string{"test-prior-value", "test-new-value"} {
+		if strings.Contains(output.String(), value) {
+			t.Errorf("unexpected value %q in logs: %s", value, output.String())
+		}
+	}
+}
@@ -1035,6 +1035,118 @@ func TestValueSemanticEquality(t *testing.T) {
 				},
 			},
 		},
+		"StringValuableWithSemanticEquals-prior-null": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringNull(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+				ProposedNewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("new"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("new"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"StringValuableWithSemanticEquals-prior-unknown": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringUnknown(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+				ProposedNewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("new"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("new"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"StringValuableWithSemanticEquals-proposed-null": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("prior"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+				ProposedNewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringNull(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringNull(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
+		"StringValuableWithSemanticEquals-proposed-unknown": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringValue("prior"),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+				ProposedNewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringUnknown(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: testtypes.StringValueWithSemanticEquals{
+					StringValue:    types.StringUnknown(),
+					SemanticEquals: true,
+					SemanticEqualsDiagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("test summary", "test detail"),
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -9775,6 +9775,42 @@ func TestAttributePlanModifyString(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-error-halts": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddAttributeError(req.Path, "Unexpected Plan Modifier Call", "The plan modifier should not be called after a prior error.")
+							resp.PlanValue = types.StringValue("unexpected")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
 		"response-planvalue": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
@@ -9798,6 +9834,45 @@ func TestAttributePlanModifyString(t *testing.T) {
 				AttributePlan: types.StringValue("testvalue"),
 			},
 		},
+		"response-planvalue-multiple-ordered": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.PlanValue = types.StringValue("first")
+						},
+					},
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							// Plan modifiers run in declaration order and
+							// receive the plan value from the prior modifier.
+							if !req.PlanValue.Equal(types.StringValue("first")) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.PlanValue",
+									fmt.Sprintf("expected first, got: %s", req.PlanValue),
+								)
+
+								return
+							}
+
+							resp.PlanValue = types.StringValue(req.PlanValue.ValueString() + "-second")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringNull(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("first-second"),
+			},
+		},
 		"response-planvalue-custom-type": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
@@ -131,6 +131,17 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		AttributeValidateDynamic(ctx, attributeWithValidators, req, resp)
 	}
 
+	if nestedAttributeWithItemBounds, ok := a.(fwschema.NestedAttributeWithItemBounds); ok {
+		resp.Diagnostics.Append(ValidateItemBounds(
+			ctx,
+			req.AttributePath,
+			attributeConfig,
+			nestedAttributeWithItemBounds.GetMinItems(),
+			nestedAttributeWithItemBounds.GetMaxItems(),
+			false,
+		)...)
+	}
+
 	AttributeValidateNestedAttributes(ctx, a, req, resp)
 
 	// Show deprecation warnings only for known values.
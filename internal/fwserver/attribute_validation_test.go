@@ -28,6 +28,33 @@ import (
 func TestAttributeValidate(t *testing.T) {
 	t.Parallel()
 
+	// Mirrors the stringvalidator.ConflictsWith validator from the
+	// terraform-plugin-framework-validators Go module, which reads a sibling
+	// attribute value from the configuration.
+	testConflictsWithOther := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			var other types.String
+
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("other"), &other)...)
+
+			// Unknown values may become null after apply, so the conflict
+			// cannot be determined yet.
+			if other.IsNull() || other.IsUnknown() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Combination",
+				"Attribute \"other\" cannot be specified when \"test\" is specified",
+			)
+		},
+	}
+
 	testCases := map[string]struct {
 		req  ValidateAttributeRequest
 		resp ValidateAttributeResponse
@@ -553,6 +580,107 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"config-sibling-null": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other": tftypes.String,
+							"test":  tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"other": tftypes.NewValue(tftypes.String, nil),
+						"test":  tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"other": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+							"test": testschema.AttributeWithStringValidators{
+								Optional: true,
+								Validators: []validator.String{
+									testConflictsWithOther,
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"config-sibling-unknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other": tftypes.String,
+							"test":  tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test":  tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"other": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+							"test": testschema.AttributeWithStringValidators{
+								Optional: true,
+								Validators: []validator.String{
+									testConflictsWithOther,
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"config-sibling-value": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other": tftypes.String,
+							"test":  tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"other": tftypes.NewValue(tftypes.String, "othervalue"),
+						"test":  tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"other": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+							"test": testschema.AttributeWithStringValidators{
+								Optional: true,
+								Validators: []validator.String{
+									testConflictsWithOther,
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Attribute Combination",
+						"Attribute \"other\" cannot be specified when \"test\" is specified",
+					),
+				},
+			},
+		},
 		"errors": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -924,6 +1052,171 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-list-single-map-validation-path": {
+			req: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"child": tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"grandchild": tftypes.Map{
+														ElementType: tftypes.Object{
+															AttributeTypes: map[string]tftypes.Type{
+																"nested_attr": tftypes.String,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"child": tftypes.Object{
+												AttributeTypes: map[string]tftypes.Type{
+													"grandchild": tftypes.Map{
+														ElementType: tftypes.Object{
+															AttributeTypes: map[string]tftypes.Type{
+																"nested_attr": tftypes.String,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"child": tftypes.Object{
+													AttributeTypes: map[string]tftypes.Type{
+														"grandchild": tftypes.Map{
+															ElementType: tftypes.Object{
+																AttributeTypes: map[string]tftypes.Type{
+																	"nested_attr": tftypes.String,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										map[string]tftypes.Value{
+											"child": tftypes.NewValue(
+												tftypes.Object{
+													AttributeTypes: map[string]tftypes.Type{
+														"grandchild": tftypes.Map{
+															ElementType: tftypes.Object{
+																AttributeTypes: map[string]tftypes.Type{
+																	"nested_attr": tftypes.String,
+																},
+															},
+														},
+													},
+												},
+												map[string]tftypes.Value{
+													"grandchild": tftypes.NewValue(
+														tftypes.Map{
+															ElementType: tftypes.Object{
+																AttributeTypes: map[string]tftypes.Type{
+																	"nested_attr": tftypes.String,
+																},
+															},
+														},
+														map[string]tftypes.Value{
+															"testkey": tftypes.NewValue(
+																tftypes.Object{
+																	AttributeTypes: map[string]tftypes.Type{
+																		"nested_attr": tftypes.String,
+																	},
+																},
+																map[string]tftypes.Value{
+																	"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+																},
+															),
+														},
+													),
+												},
+											),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"child": testschema.NestedAttribute{
+											NestedObject: testschema.NestedAttributeObject{
+												Attributes: map[string]fwschema.Attribute{
+													"grandchild": testschema.NestedAttribute{
+														NestedObject: testschema.NestedAttributeObject{
+															Attributes: map[string]fwschema.Attribute{
+																"nested_attr": testschema.AttributeWithStringValidators{
+																	Required: true,
+																	Validators: []validator.String{
+																		testvalidator.String{
+																			ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+																				expectedPathExpression := path.MatchRoot("test").AtListIndex(0).AtName("child").AtName("grandchild").AtMapKey("testkey").AtName("nested_attr")
+
+																				if !req.PathExpression.Equal(expectedPathExpression) {
+																					resp.Diagnostics.AddError(
+																						"Unexpected StringRequest.PathExpression",
+																						fmt.Sprintf("expected %s, got: %s", expectedPathExpression, req.PathExpression),
+																					)
+																				}
+
+																				resp.Diagnostics.AddAttributeError(req.Path, "Invalid Nested Value", "test detail")
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														NestingMode: fwschema.NestingModeMap,
+														Required:    true,
+													},
+												},
+											},
+											NestingMode: fwschema.NestingModeSingle,
+											Required:    true,
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeList,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0).AtName("child").AtName("grandchild").AtMapKey("testkey").AtName("nested_attr"),
+						"Invalid Nested Value",
+						"test detail",
+					),
+				},
+			},
+		},
 		"nested-attr-map-no-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -46,6 +46,19 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 		BlockValidateSet(ctx, blockWithValidators, req, resp)
 	}
 
+	// Terraform enforces block item bounds, except when the number of blocks
+	// is unknown, such as dynamic blocks, so these are also enforced here.
+	if blockWithItemBounds, ok := b.(fwschema.BlockWithItemBounds); ok {
+		resp.Diagnostics.Append(ValidateItemBounds(
+			ctx,
+			req.AttributePath,
+			attributeConfig,
+			blockWithItemBounds.GetMinItems(),
+			blockWithItemBounds.GetMaxItems(),
+			true,
+		)...)
+	}
+
 	nestedBlockObject := b.GetNestedObject()
 
 	nm := b.GetNestingMode()
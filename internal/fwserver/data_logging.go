@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// logConfig logs the configuration data at trace level, if the Server is
+// configured with TraceDataLogging.
+func (s *Server) logConfig(ctx context.Context, direction string, config *tfsdk.Config) {
+	if config == nil {
+		return
+	}
+
+	s.logData(ctx, direction, fwschemadata.DataDescriptionConfiguration, config.Schema, config.Raw)
+}
+
+// logPlan logs the plan data at trace level, if the Server is configured with
+// TraceDataLogging.
+func (s *Server) logPlan(ctx context.Context, direction string, plan *tfsdk.Plan) {
+	if plan == nil {
+		return
+	}
+
+	s.logData(ctx, direction, fwschemadata.DataDescriptionPlan, plan.Schema, plan.Raw)
+}
+
+// logState logs the state data at trace level, if the Server is configured
+// with TraceDataLogging.
+func (s *Server) logState(ctx context.Context, direction string, state *tfsdk.State) {
+	if state == nil {
+		return
+	}
+
+	s.logData(ctx, direction, fwschemadata.DataDescriptionState, state.Schema, state.Raw)
+}
+
+// logData logs every attribute path and value of the data at trace level, if
+// the Server is configured with TraceDataLogging. Sensitive attribute values
+// are always redacted.
+func (s *Server) logData(ctx context.Context, direction string, description fwschemadata.DataDescription, schema fwschema.Schema, raw tftypes.Value) {
+	if !s.TraceDataLogging || schema == nil {
+		return
+	}
+
+	logging.FrameworkTrace(
+		ctx,
+		"Decoded "+direction+" "+description.String(),
+		map[string]interface{}{
+			logging.KeyData: dataLogString(ctx, schema, raw),
+		},
+	)
+}
+
+// dataLogString returns each attribute path and value of the data, one per
+// line and sorted by path. Sensitive attribute values, including all values
+// underneath sensitive nested attributes, are redacted.
+func dataLogString(ctx context.Context, schema fwschema.Schema, raw tftypes.Value) string {
+	if raw.IsNull() {
+		return raw.String()
+	}
+
+	var lines []string
+
+	_ = tftypes.Walk(raw, func(tfTypePath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		attribute, err := schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// The root object, elements, and blocks are not attributes. Continue
+		// walking so nested attributes can be logged.
+		if err != nil {
+			return true, nil
+		}
+
+		fwPath, diags := fromtftypes.AttributePath(ctx, tfTypePath, schema)
+
+		if diags.HasError() {
+			return false, nil
+		}
+
+		if _, ok := attribute.(fwschema.NestedAttribute); ok && !attribute.IsSensitive() && !value.IsNull() && value.IsKnown() {
+			return true, nil
+		}
+
+		lines = append(lines, fwPath.String()+": "+logging.Value(value, attribute.IsSensitive()))
+
+		return false, nil
+	})
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
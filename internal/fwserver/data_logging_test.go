@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerTraceDataLogging(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_nested_not_sensitive": tftypes.String,
+					"test_nested_sensitive":     tftypes.String,
+				},
+			},
+			"test_not_sensitive": tftypes.String,
+			"test_sensitive":     tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_nested_not_sensitive": schema.StringAttribute{
+						Optional: true,
+					},
+					"test_nested_sensitive": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+				Optional: true,
+			},
+			"test_not_sensitive": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_sensitive": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_nested": tftypes.NewValue(testType.AttributeTypes["test_nested"], map[string]tftypes.Value{
+				"test_nested_not_sensitive": tftypes.NewValue(tftypes.String, "test-nested-not-sensitive-value"),
+				"test_nested_sensitive":     tftypes.NewValue(tftypes.String, "test-nested-sensitive-value"),
+			}),
+			"test_not_sensitive": tftypes.NewValue(tftypes.String, "test-not-sensitive-value"),
+			"test_sensitive":     tftypes.NewValue(tftypes.String, "test-sensitive-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testCases := map[string]struct {
+		traceDataLogging bool
+		expectedContains []string
+	}{
+		"disabled": {
+			traceDataLogging: false,
+		},
+		"enabled": {
+			traceDataLogging: true,
+			expectedContains: []string{
+				"Decoded request state",
+				"Decoded response state",
+				"test_nested.test_nested_not_sensitive: tftypes.String",
+				"test-nested-not-sensitive-value",
+				"test_nested.test_nested_sensitive: " + logging.RedactedValue,
+				"test_not_sensitive: tftypes.String",
+				"test-not-sensitive-value",
+				"test_sensitive: " + logging.RedactedValue,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+			ctx = logging.InitContext(ctx)
+
+			server := &fwserver.Server{
+				Provider:         &testprovider.Provider{},
+				TraceDataLogging: testCase.traceDataLogging,
+			}
+
+			resp := &fwserver.ReadResourceResponse{}
+
+			server.ReadResource(ctx, &fwserver.ReadResourceRequest{
+				CurrentState: testState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {},
+				},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", resp.Diagnostics)
+			}
+
+			logs := output.String()
+
+			if !testCase.traceDataLogging && strings.Contains(logs, "Decoded ") {
+				t.Errorf("unexpected data logs: %s", logs)
+			}
+
+			for _, expected := range testCase.expectedContains {
+				if !strings.Contains(logs, expected) {
+					t.Errorf("expected logs to contain %q, got: %s", expected, logs)
+				}
+			}
+
+			for _, sensitive := range []string{"test-sensitive-value", "test-nested-sensitive-value"} {
+				if strings.Contains(logs, sensitive) {
+					t.Errorf("unexpected sensitive value %q in logs: %s", sensitive, logs)
+				}
+			}
+		})
+	}
+}
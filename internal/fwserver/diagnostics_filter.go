@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// filterDiagnostics removes the warning diagnostics for which the
+// DiagnosticsFilter returns true, if set. Error diagnostics are never
+// removed, so filtering cannot change the outcome of an RPC.
+func (s *Server) filterDiagnostics(ctx context.Context, diags *diag.Diagnostics) {
+	if s.DiagnosticsFilter == nil || len(*diags) == 0 {
+		return
+	}
+
+	var result diag.Diagnostics
+
+	for _, d := range *diags {
+		if d.Severity() != diag.SeverityError && s.DiagnosticsFilter(d) {
+			logging.FrameworkDebug(ctx, "Removed warning diagnostic matching DiagnosticsFilter")
+
+			continue
+		}
+
+		result = append(result, d)
+	}
+
+	*diags = result
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerDiagnosticsFilter(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test-value"),
+			},
+		),
+		Schema: testSchema,
+	}
+
+	testResource := &testprovider.ResourceWithValidateConfig{
+		Resource: &testprovider.Resource{
+			SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+		},
+		ValidateConfigMethod: func(_ context.Context, _ resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+			resp.Diagnostics.AddWarning("Advisory", "advisory warning detail")
+			resp.Diagnostics.AddWarning("Other", "other warning detail")
+			resp.Diagnostics.AddError("Advisory", "advisory error detail")
+		},
+	}
+
+	filterAdvisory := func(d diag.Diagnostic) bool {
+		return d.Summary() == "Advisory"
+	}
+
+	testCases := map[string]struct {
+		server        *fwserver.Server
+		expectedDiags diag.Diagnostics
+	}{
+		"no-filter": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Advisory", "advisory warning detail"),
+				diag.NewWarningDiagnostic("Other", "other warning detail"),
+				diag.NewErrorDiagnostic("Advisory", "advisory error detail"),
+			},
+		},
+		"filter": {
+			server: &fwserver.Server{
+				DiagnosticsFilter: filterAdvisory,
+				Provider:          &testprovider.Provider{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Other", "other warning detail"),
+				diag.NewErrorDiagnostic("Advisory", "advisory error detail"),
+			},
+		},
+		"filter-no-match": {
+			server: &fwserver.Server{
+				DiagnosticsFilter: func(diag.Diagnostic) bool { return false },
+				Provider:          &testprovider.Provider{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Advisory", "advisory warning detail"),
+				diag.NewWarningDiagnostic("Other", "other warning detail"),
+				diag.NewErrorDiagnostic("Advisory", "advisory error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &fwserver.ValidateResourceConfigRequest{
+				Config:   testConfig,
+				Resource: testResource,
+			}
+			response := &fwserver.ValidateResourceConfigResponse{}
+
+			testCase.server.ValidateResourceConfig(context.Background(), request, response)
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// inconsistentResultDiag returns the diagnostic for a failed framework check
+// of an unexpected resource result. This is an error diagnostic, unless the
+// server InconsistentResultWarnings field is enabled.
+func (s *Server) inconsistentResultDiag(summary string, detail string) diag.Diagnostic {
+	if s.InconsistentResultWarnings {
+		return diag.NewWarningDiagnostic(summary, detail)
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail)
+}
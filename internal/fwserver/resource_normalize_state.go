@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceNormalizeState calls the resource NormalizeState method, if
+// implemented, and updates the given new state with the result. The new state
+// is left unchanged if the method returns error diagnostics, so Terraform can
+// still track a resource which was successfully created or updated.
+func ResourceNormalizeState(ctx context.Context, r resource.Resource, config tfsdk.Config, plan tfsdk.Plan, newState *tfsdk.State) diag.Diagnostics {
+	resourceWithNormalizeState, ok := r.(resource.ResourceWithNormalizeState)
+
+	if !ok || newState == nil {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithNormalizeState")
+
+	normalizeReq := resource.NormalizeStateRequest{
+		Config: config,
+		Plan:   plan,
+		State: tfsdk.State{
+			Schema: newState.Schema,
+			Raw:    newState.Raw.Copy(),
+		},
+	}
+	normalizeResp := resource.NormalizeStateResponse{
+		State: tfsdk.State{
+			Schema: newState.Schema,
+			Raw:    newState.Raw.Copy(),
+		},
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource NormalizeState")
+	resourceWithNormalizeState.NormalizeState(ctx, normalizeReq, &normalizeResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource NormalizeState")
+
+	if normalizeResp.Diagnostics.HasError() {
+		return normalizeResp.Diagnostics
+	}
+
+	if !normalizeResp.State.Raw.Equal(newState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to resource NormalizeState")
+	}
+
+	newState.Raw = normalizeResp.State.Raw
+
+	return normalizeResp.Diagnostics
+}
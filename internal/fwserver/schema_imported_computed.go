@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// SchemaImportedComputedValues returns a warning diagnostic for each
+// computed-only attribute whose value is known and not null in the imported
+// state after the resource ImportState method. String values which equal the
+// import identifier are excluded, since setting them is the purpose of import,
+// such as with ImportStatePassthroughID.
+func SchemaImportedComputedValues(ctx context.Context, s fwschema.Schema, importID string, importedState tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if importedState.IsNull() {
+		return diags
+	}
+
+	_ = tftypes.Walk(importedState, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// The root object, elements, and paths inside dynamic attributes are
+		// not attributes. Continue walking as no attribute information is
+		// available for the current path.
+		if err != nil {
+			return true, nil
+		}
+
+		if tfTypeValue.IsNull() || !tfTypeValue.IsFullyKnown() {
+			return false, nil
+		}
+
+		if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+			return true, nil
+		}
+
+		if importIdentifierValue(importID, tfTypeValue) {
+			return false, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, s)
+
+		diags.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return false, nil
+		}
+
+		logging.FrameworkDebug(ctx, "Resource ImportState set computed attribute value")
+
+		diags.AddAttributeWarning(
+			fwPath,
+			"Computed Attribute Set During Import",
+			"The resource ImportState method set a known value for an attribute which is only computed. "+
+				"Terraform refreshes the imported resource with the Read method, which should set computed attribute values instead. "+
+				"Values set during import may be inconsistent with the remote system, which may cause unexpected differences.\n\n"+
+				"This is likely an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return false, nil
+	})
+
+	return diags
+}
+
+// importIdentifierValue returns true if the value is a string which equals the
+// import identifier.
+func importIdentifierValue(importID string, value tftypes.Value) bool {
+	if !value.Type().Is(tftypes.String) {
+		return false
+	}
+
+	var stringValue string
+
+	if err := value.As(&stringValue); err != nil {
+		return false
+	}
+
+	return stringValue == importID
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValidateItemBounds returns an error diagnostic if the number of elements in
+// the collection value is less than minItems or greater than maxItems. A zero
+// minItems or maxItems represents no bound. Unknown values are skipped, as the
+// number of elements is not known yet. Null values are treated as having no
+// elements if block is true, otherwise skipped.
+func ValidateItemBounds(ctx context.Context, p path.Path, value attr.Value, minItems int64, maxItems int64, block bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if (minItems == 0 && maxItems == 0) || value == nil || value.IsUnknown() {
+		return diags
+	}
+
+	if value.IsNull() && !block {
+		return diags
+	}
+
+	var count int64
+
+	if !value.IsNull() {
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Item Bounds Validation Error",
+				"An unexpected error occurred while converting the value for item bounds validation. "+
+					"Please report this to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return diags
+		}
+
+		switch tfValue.Type().(type) {
+		case tftypes.Map:
+			var elements map[string]tftypes.Value
+
+			err = tfValue.As(&elements)
+			count = int64(len(elements))
+		default:
+			var elements []tftypes.Value
+
+			err = tfValue.As(&elements)
+			count = int64(len(elements))
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Item Bounds Validation Error",
+				"An unexpected error occurred while converting the value for item bounds validation. "+
+					"Please report this to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return diags
+		}
+	}
+
+	summary := "Invalid Attribute Value"
+	kind := "Attribute"
+	items := "elements"
+
+	if block {
+		summary = "Invalid Block"
+		kind = "Block"
+		items = "blocks"
+	}
+
+	if minItems > 0 && count < minItems {
+		diags.AddAttributeError(
+			p,
+			summary,
+			fmt.Sprintf("%s %s must contain at least %d %s, got: %d", kind, p, minItems, items, count),
+		)
+	}
+
+	if maxItems > 0 && count > maxItems {
+		diags.AddAttributeError(
+			p,
+			summary,
+			fmt.Sprintf("%s %s must contain at most %d %s, got: %d", kind, p, maxItems, items, count),
+		)
+	}
+
+	return diags
+}
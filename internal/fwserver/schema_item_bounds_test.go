@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestAttributeValidateItemBounds(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+	testObjectValue := tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+		"nested": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+	testNestedObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"nested": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list": schema.ListNestedAttribute{
+				NestedObject: testNestedObject,
+				MinItems:     1,
+				MaxItems:     2,
+				Optional:     true,
+			},
+			"test_map": schema.MapNestedAttribute{
+				NestedObject: testNestedObject,
+				MinItems:     1,
+				MaxItems:     2,
+				Optional:     true,
+			},
+		},
+	}
+	testConfig := func(list tftypes.Value, m tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_list": tftypes.List{ElementType: testObjectType},
+						"test_map":  tftypes.Map{ElementType: testObjectType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_list": list,
+					"test_map":  m,
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		attribute string
+		config    tfsdk.Config
+		expected  diag.Diagnostics
+	}{
+		"list-null": {
+			attribute: "test_list",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, nil),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, nil),
+			),
+		},
+		"list-unknown": {
+			attribute: "test_list",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, nil),
+			),
+		},
+		"list-under-min": {
+			attribute: "test_list",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, []tftypes.Value{}),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, nil),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid Attribute Value",
+					"Attribute test_list must contain at least 1 elements, got: 0",
+				),
+			},
+		},
+		"list-within-bounds": {
+			attribute: "test_list",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, []tftypes.Value{testObjectValue, testObjectValue}),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, nil),
+			),
+		},
+		"list-over-max": {
+			attribute: "test_list",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, []tftypes.Value{testObjectValue, testObjectValue, testObjectValue}),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, nil),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid Attribute Value",
+					"Attribute test_list must contain at most 2 elements, got: 3",
+				),
+			},
+		},
+		"map-under-min": {
+			attribute: "test_map",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, nil),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, map[string]tftypes.Value{}),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_map"),
+					"Invalid Attribute Value",
+					"Attribute test_map must contain at least 1 elements, got: 0",
+				),
+			},
+		},
+		"map-over-max": {
+			attribute: "test_map",
+			config: testConfig(
+				tftypes.NewValue(tftypes.List{ElementType: testObjectType}, nil),
+				tftypes.NewValue(tftypes.Map{ElementType: testObjectType}, map[string]tftypes.Value{
+					"one":   testObjectValue,
+					"two":   testObjectValue,
+					"three": testObjectValue,
+				}),
+			),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_map"),
+					"Invalid Attribute Value",
+					"Attribute test_map must contain at most 2 elements, got: 3",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := fwserver.ValidateAttributeRequest{
+				AttributePath:           path.Root(testCase.attribute),
+				AttributePathExpression: path.MatchRoot(testCase.attribute),
+				Config:                  testCase.config,
+			}
+			resp := &fwserver.ValidateAttributeResponse{}
+
+			fwserver.AttributeValidate(context.Background(), testSchema.Attributes[testCase.attribute], req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBlockValidateItemBounds(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+	testObjectValue := tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+		"nested": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"test": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"nested": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				MinItems: 1,
+				MaxItems: 1,
+			},
+		},
+	}
+	testConfig := func(value tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Set{ElementType: testObjectType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": value,
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		// Blocks are never null in Terraform, unconfigured blocks are
+		// converted to null by the framework.
+		"null-under-min": {
+			config: testConfig(tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, nil)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Block",
+					"Block test must contain at least 1 blocks, got: 0",
+				),
+			},
+		},
+		"unknown": {
+			config: testConfig(tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, tftypes.UnknownValue)),
+		},
+		"within-bounds": {
+			config: testConfig(tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, []tftypes.Value{testObjectValue})),
+		},
+		"over-max": {
+			config: testConfig(tftypes.NewValue(tftypes.Set{ElementType: testObjectType}, []tftypes.Value{
+				testObjectValue,
+				tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, "other-value"),
+				}),
+			})),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Block",
+					"Block test must contain at most 1 blocks, got: 2",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := fwserver.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				Config:                  testCase.config,
+			}
+			resp := &fwserver.ValidateAttributeResponse{}
+
+			fwserver.BlockValidate(context.Background(), testSchema.Blocks["test"], req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBlockValidateItemBoundsNestedBlock(t *testing.T) {
+	t.Parallel()
+
+	testInnerObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tftypes.String,
+		},
+	}
+	testOuterObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"inner": tftypes.List{ElementType: testInnerObjectType},
+		},
+	}
+	testSchema := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"test": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"inner": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"nested": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							MinItems: 1,
+						},
+					},
+				},
+			},
+		},
+	}
+	testConfig := func(inner tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{ElementType: testOuterObjectType},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{ElementType: testOuterObjectType}, []tftypes.Value{
+						tftypes.NewValue(testOuterObjectType, map[string]tftypes.Value{
+							"inner": inner,
+						}),
+					}),
+				},
+			),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		config   tfsdk.Config
+		expected diag.Diagnostics
+	}{
+		"under-min": {
+			config: testConfig(tftypes.NewValue(tftypes.List{ElementType: testInnerObjectType}, []tftypes.Value{})),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtName("inner"),
+					"Invalid Block",
+					"Block test[0].inner must contain at least 1 blocks, got: 0",
+				),
+			},
+		},
+		"within-bounds": {
+			config: testConfig(tftypes.NewValue(tftypes.List{ElementType: testInnerObjectType}, []tftypes.Value{
+				tftypes.NewValue(testInnerObjectType, map[string]tftypes.Value{
+					"nested": tftypes.NewValue(tftypes.String, "test-value"),
+				}),
+			})),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := fwserver.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				Config:                  testCase.config,
+			}
+			resp := &fwserver.ValidateAttributeResponse{}
+
+			fwserver.BlockValidate(context.Background(), testSchema.Blocks["test"], req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// SchemaMustSetOnRead returns a warning diagnostic for each attribute with
+// MustSetOnRead enabled whose new state value after the resource Read method
+// is null or unknown. Known values which are unchanged from the prior state
+// value are only logged.
+func SchemaMustSetOnRead(ctx context.Context, s fwschema.Schema, priorState tftypes.Value, newState tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if newState.IsNull() {
+		return diags
+	}
+
+	_ = tftypes.Walk(newState, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		// The root object, elements, and paths inside dynamic attributes are
+		// not attributes. Continue walking as no attribute information is
+		// available for the current path.
+		if err != nil {
+			return true, nil
+		}
+
+		mustSetAttribute, ok := attribute.(fwschema.AttributeWithMustSetOnRead)
+
+		if !ok || !mustSetAttribute.GetMustSetOnRead() {
+			return true, nil
+		}
+
+		// A known value which is unchanged from the prior state may be
+		// correct, such as when the remote value has not changed, so it is
+		// only logged for provider developers.
+		if !tfTypeValue.IsNull() && tfTypeValue.IsKnown() {
+			rawPriorValue, _, err := tftypes.WalkAttributePath(priorState, tfTypePath)
+
+			if err != nil {
+				return false, nil
+			}
+
+			priorValue, ok := rawPriorValue.(tftypes.Value)
+
+			if ok && priorValue.Equal(tfTypeValue) {
+				logging.FrameworkDebug(
+					ctx,
+					"Resource Read did not change MustSetOnRead attribute value from the prior state",
+					map[string]interface{}{logging.KeyAttributePath: tfTypePath.String()},
+				)
+			}
+
+			return false, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, s)
+
+		diags.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return false, nil
+		}
+
+		diags.AddAttributeWarning(
+			fwPath,
+			"Computed Attribute Not Refreshed",
+			"The resource Read method did not refresh the value of an attribute which must be set on every read. "+
+				"The value is null or unknown, which may cause Terraform to show unexpected differences. "+
+				"This is likely an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return false, nil
+	})
+
+	return diags
+}
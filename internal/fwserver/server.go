@@ -33,15 +33,41 @@ type Server struct {
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// DiagnosticsFilter removes warning diagnostics from RPC responses when it
+	// returns true. Error diagnostics are never removed.
+	DiagnosticsFilter func(diag.Diagnostic) bool
+
+	// InconsistentResultWarnings returns the framework checks of unexpected
+	// resource results as warning diagnostics instead of error diagnostics.
+	// These checks cover missing resource state after create or update and
+	// resource state planned for destruction. Defaults to error diagnostics.
+	InconsistentResultWarnings bool
+
+	// TraceDataLogging logs the decoded configuration, plan, and state data
+	// of resource, data source, and provider RPCs at trace level. Sensitive
+	// attribute values are always redacted.
+	TraceDataLogging bool
+
+	// UpdateNewStateFromPlan pre-populates the resource Update response state
+	// with the planned state instead of the prior state. When enabled, an
+	// Update implementation which does not set the response state returns the
+	// planned state as the new state.
+	UpdateNewStateFromPlan bool
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
 	dataSourceSchemas map[string]fwschema.Schema
 
 	// dataSourceSchemasMutex is a mutex to protect concurrent dataSourceSchemas
-	// access from race conditions.
+	// and dataSourceSchemaCalls access from race conditions.
 	dataSourceSchemasMutex sync.RWMutex
 
+	// dataSourceSchemaCalls is the in-progress DataSource Schema method calls
+	// by type name, so concurrent RPCs for the same type name only call the
+	// method once without blocking RPCs for other type names.
+	dataSourceSchemaCalls map[string]*schemaCall
+
 	// dataSourceFuncs is the cached DataSource functions for RPCs that need to
 	// access data sources. If not found, it will be fetched from the
 	// Provider.DataSources() method.
@@ -112,18 +138,27 @@ type Server struct {
 	providerTypeName string
 
 	// providerTypeNameMutex is a mutex to protect concurrent providerTypeName
-	// access from race conditions.
+	// and providerVersion access from race conditions.
 	providerTypeNameMutex sync.Mutex
 
+	// providerVersion is the cached version of the provider, if the provider
+	// implemented the Metadata method. Access this field with the Server.ProviderVersion() method.
+	providerVersion string
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
 	resourceSchemas map[string]fwschema.Schema
 
 	// resourceSchemasMutex is a mutex to protect concurrent resourceSchemas
-	// access from race conditions.
+	// and resourceSchemaCalls access from race conditions.
 	resourceSchemasMutex sync.RWMutex
 
+	// resourceSchemaCalls is the in-progress Resource Schema method calls by
+	// type name, so concurrent RPCs for the same type name only call the
+	// method once without blocking RPCs for other type names.
+	resourceSchemaCalls map[string]*schemaCall
+
 	// resourceFuncs is the cached Resource functions for RPCs that need to
 	// access resources. If not found, it will be fetched from the
 	// Provider.Resources() method.
@@ -139,6 +174,14 @@ type Server struct {
 	resourceTypesMutex sync.Mutex
 }
 
+// schemaCall is an in-progress provider defined Schema method call. The done
+// channel is closed after the schema and diags fields are set.
+type schemaCall struct {
+	done   chan struct{}
+	schema fwschema.Schema
+	diags  diag.Diagnostics
+}
+
 // DataSource returns the DataSource for a given type name.
 func (s *Server) DataSource(ctx context.Context, typeName string) (datasource.DataSource, diag.Diagnostics) {
 	dataSourceFuncs, diags := s.DataSourceFuncs(ctx)
@@ -239,6 +282,65 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 		return dataSourceSchema, nil
 	}
 
+	s.dataSourceSchemasMutex.Lock()
+
+	dataSourceSchema, ok = s.dataSourceSchemas[typeName]
+
+	if ok {
+		s.dataSourceSchemasMutex.Unlock()
+
+		return dataSourceSchema, nil
+	}
+
+	// Wait for an in-progress call for the same type name, otherwise start
+	// a new call. The lock is not held while calling the provider defined
+	// Schema method, so RPCs for other type names are not blocked.
+	call, ok := s.dataSourceSchemaCalls[typeName]
+
+	if ok {
+		s.dataSourceSchemasMutex.Unlock()
+
+		<-call.done
+
+		return call.schema, call.diags
+	}
+
+	call = &schemaCall{
+		done: make(chan struct{}),
+	}
+
+	if s.dataSourceSchemaCalls == nil {
+		s.dataSourceSchemaCalls = make(map[string]*schemaCall)
+	}
+
+	s.dataSourceSchemaCalls[typeName] = call
+
+	s.dataSourceSchemasMutex.Unlock()
+
+	call.schema, call.diags = s.dataSourceSchemaCall(ctx, typeName)
+
+	s.dataSourceSchemasMutex.Lock()
+
+	if !call.diags.HasError() {
+		if s.dataSourceSchemas == nil {
+			s.dataSourceSchemas = make(map[string]fwschema.Schema)
+		}
+
+		s.dataSourceSchemas[typeName] = call.schema
+	}
+
+	delete(s.dataSourceSchemaCalls, typeName)
+
+	s.dataSourceSchemasMutex.Unlock()
+
+	close(call.done)
+
+	return call.schema, call.diags
+}
+
+// dataSourceSchemaCall calls the provider defined DataSource Schema method for
+// the given type name without caching the result.
+func (s *Server) dataSourceSchemaCall(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	dataSource, dataSourceDiags := s.DataSource(ctx, typeName)
@@ -258,20 +360,6 @@ func (s *Server) DataSourceSchema(ctx context.Context, typeName string) (fwschem
 
 	diags.Append(schemaResp.Diagnostics...)
 
-	if diags.HasError() {
-		return schemaResp.Schema, diags
-	}
-
-	s.dataSourceSchemasMutex.Lock()
-
-	if s.dataSourceSchemas == nil {
-		s.dataSourceSchemas = make(map[string]fwschema.Schema)
-	}
-
-	s.dataSourceSchemas[typeName] = schemaResp.Schema
-
-	s.dataSourceSchemasMutex.Unlock()
-
 	return schemaResp.Schema, diags
 }
 
@@ -332,10 +420,22 @@ func (s *Server) ProviderTypeName(ctx context.Context) string {
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Metadata")
 
 	s.providerTypeName = metadataResp.TypeName
+	s.providerVersion = metadataResp.Version
 
 	return s.providerTypeName
 }
 
+// ProviderVersion returns the Version associated with the Provider. The
+// Version is cached on first use.
+func (s *Server) ProviderVersion(ctx context.Context) string {
+	_ = s.ProviderTypeName(ctx)
+
+	s.providerTypeNameMutex.Lock()
+	defer s.providerTypeNameMutex.Unlock()
+
+	return s.providerVersion
+}
+
 // ProviderSchema returns the Schema associated with the Provider. The Schema
 // and Diagnostics are cached on first use.
 func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diagnostics) {
@@ -496,6 +596,65 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 		return resourceSchema, nil
 	}
 
+	s.resourceSchemasMutex.Lock()
+
+	resourceSchema, ok = s.resourceSchemas[typeName]
+
+	if ok {
+		s.resourceSchemasMutex.Unlock()
+
+		return resourceSchema, nil
+	}
+
+	// Wait for an in-progress call for the same type name, otherwise start
+	// a new call. The lock is not held while calling the provider defined
+	// Schema method, so RPCs for other type names are not blocked.
+	call, ok := s.resourceSchemaCalls[typeName]
+
+	if ok {
+		s.resourceSchemasMutex.Unlock()
+
+		<-call.done
+
+		return call.schema, call.diags
+	}
+
+	call = &schemaCall{
+		done: make(chan struct{}),
+	}
+
+	if s.resourceSchemaCalls == nil {
+		s.resourceSchemaCalls = make(map[string]*schemaCall)
+	}
+
+	s.resourceSchemaCalls[typeName] = call
+
+	s.resourceSchemasMutex.Unlock()
+
+	call.schema, call.diags = s.resourceSchemaCall(ctx, typeName)
+
+	s.resourceSchemasMutex.Lock()
+
+	if !call.diags.HasError() {
+		if s.resourceSchemas == nil {
+			s.resourceSchemas = make(map[string]fwschema.Schema)
+		}
+
+		s.resourceSchemas[typeName] = call.schema
+	}
+
+	delete(s.resourceSchemaCalls, typeName)
+
+	s.resourceSchemasMutex.Unlock()
+
+	close(call.done)
+
+	return call.schema, call.diags
+}
+
+// resourceSchemaCall calls the provider defined Resource Schema method for
+// the given type name without caching the result.
+func (s *Server) resourceSchemaCall(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	r, resourceDiags := s.Resource(ctx, typeName)
@@ -515,20 +674,6 @@ func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.
 
 	diags.Append(schemaResp.Diagnostics...)
 
-	if diags.HasError() {
-		return schemaResp.Schema, diags
-	}
-
-	s.resourceSchemasMutex.Lock()
-
-	if s.resourceSchemas == nil {
-		s.resourceSchemas = make(map[string]fwschema.Schema)
-	}
-
-	s.resourceSchemas[typeName] = schemaResp.Schema
-
-	s.resourceSchemasMutex.Unlock()
-
 	return schemaResp.Schema, diags
 }
 
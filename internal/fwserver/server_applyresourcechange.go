@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ApplyResourceChangeRequest is the framework server request for the
@@ -36,10 +37,35 @@ type ApplyResourceChangeResponse struct {
 
 // ApplyResourceChange implements the framework server ApplyResourceChange RPC.
 func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest, resp *ApplyResourceChangeResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+	s.logPlan(ctx, "request", req.PlannedState)
+	s.logState(ctx, "request", req.PriorState)
+
+	defer func() { s.logState(ctx, "response", resp.NewState) }()
+
+	// If both PriorState and PlannedState are missing/null, there is nothing
+	// to create or delete, such as destroying a resource which was already
+	// removed. Return a null NewState without calling any provider-defined
+	// logic.
+	if (req.PriorState == nil || req.PriorState.Raw.IsNull()) && (req.PlannedState == nil || req.PlannedState.Raw.IsNull()) {
+		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState and no PlannedState, skipping")
+
+		if req.ResourceSchema != nil {
+			resp.NewState = &tfsdk.State{
+				Schema: req.ResourceSchema,
+				Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+			}
+		}
+
+		return
+	}
+
 	// If PriorState is missing/null, its a Create request.
 	if req.PriorState == nil || req.PriorState.Raw.IsNull() {
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
@@ -273,6 +273,13 @@ func TestServerApplyResourceChange(t *testing.T) {
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.ApplyResourceChangeRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
 				PriorState:     testEmptyState,
 				ResourceSchema: testSchema,
 				Resource: &testprovider.Resource{
@@ -403,7 +410,13 @@ func TestServerApplyResourceChange(t *testing.T) {
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.ApplyResourceChangeRequest{
-				PlannedState:   testEmptyPlan,
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
 				PriorState:     testEmptyState,
 				ResourceSchema: testSchema,
 				Resource: &testprovider.Resource{
@@ -438,6 +451,52 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 		},
+		"noop-priorstate-plannedstate-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				PlannedState:   testEmptyPlan,
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Create")
+					},
+					DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Delete")
+					},
+					UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Update")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				NewState: testEmptyState,
+			},
+		},
+		"noop-priorstate-plannedstate-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ApplyResourceChangeRequest{
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Create")
+					},
+					DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Delete")
+					},
+					UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+						resp.Diagnostics.AddError("Unexpected Method Call", "Expected: none, Got: Update")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ApplyResourceChangeResponse{
+				NewState: testEmptyState,
+			},
+		},
 		"delete-request-priorstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
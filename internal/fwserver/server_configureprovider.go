@@ -7,11 +7,24 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/useragent"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
+	var terraformVersion string
+
+	if req != nil {
+		terraformVersion = req.TerraformVersion
+
+		s.logConfig(ctx, "request", &req.Config)
+	}
+
+	ctx = useragent.NewContext(ctx, useragent.New(terraformVersion, s.ProviderTypeName(ctx), s.ProviderVersion(ctx)))
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
 
 	if req != nil {
@@ -98,6 +98,48 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"useragent": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "examplecloud"
+						resp.Version = "1.2.3"
+					},
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						expected := "Terraform/1.0.0 (+https://www.terraform.io) terraform-provider-examplecloud/1.2.3"
+
+						if got := provider.UserAgent(ctx); got != expected {
+							resp.Diagnostics.AddError("Incorrect provider.UserAgent", "expected "+expected+", got "+got)
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				TerraformVersion: "1.0.0",
+			},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
+		"useragent-no-terraformversion": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "examplecloud"
+						resp.Version = "1.2.3"
+					},
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						expected := "terraform-provider-examplecloud/1.2.3"
+
+						if got := provider.UserAgent(ctx); got != expected {
+							resp.Diagnostics.AddError("Incorrect provider.UserAgent", "expected "+expected+", got "+got)
+						}
+					},
+				},
+			},
+			request:          &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{},
+		},
 		"response-datasourcedata": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -75,14 +75,11 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		},
 	}
 
-	privateProviderData := privatestate.EmptyProviderData(ctx)
-
 	createResp := resource.CreateResponse{
 		State: tfsdk.State{
 			Schema: req.ResourceSchema,
 			Raw:    nullSchemaData,
 		},
-		Private: privateProviderData,
 	}
 
 	if req.Config != nil {
@@ -97,6 +94,20 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	privateProviderData := privatestate.EmptyProviderData(ctx)
+
+	createReq.Private = privateProviderData
+	createResp.Private = privateProviderData
+
+	if req.PlannedPrivate != nil {
+		if req.PlannedPrivate.Provider != nil {
+			createReq.Private = req.PlannedPrivate.Provider
+			createResp.Private = req.PlannedPrivate.Provider
+		}
+
+		resp.Private = req.PlannedPrivate
+	}
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Create")
 	req.Resource.Create(ctx, createReq, &createResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Create")
@@ -114,10 +125,10 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 			detail += " Import the resource if the resource was actually created and Terraform should be tracking it."
 		}
 
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(s.inconsistentResultDiag(
 			"Missing Resource State After Create",
 			detail,
-		)
+		))
 	}
 
 	if createResp.Private != nil {
@@ -156,11 +167,13 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
-	}
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	// Normalize the new state after semantic equality, so semantic equality
+	// does not replace normalized values with planned values.
+	resp.Diagnostics.Append(ResourceNormalizeState(ctx, req.Resource, createReq.Config, createReq.Plan, resp.NewState)...)
 }
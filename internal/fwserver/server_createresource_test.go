@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -433,6 +434,99 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-normalizestate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithNormalizeState{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							var data testSchemaData
+
+							resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+							data.TestComputed = types.StringValue("TEST-COMPUTED-VALUE")
+
+							resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						},
+					},
+					NormalizeStateMethod: func(ctx context.Context, req resource.NormalizeStateRequest, resp *resource.NormalizeStateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue(strings.ToLower(data.TestComputed.ValueString()))
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-normalizestate-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithNormalizeState{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+					NormalizeStateMethod: func(ctx context.Context, req resource.NormalizeStateRequest, resp *resource.NormalizeStateResponse) {
+						resp.State.Raw = tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+							"test_computed": tftypes.NewValue(tftypes.String, "test-normalized-value"),
+							"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+						})
+
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+				// The state returned by Create is preserved on error.
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate-null": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -466,6 +560,40 @@ func TestServerCreateResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-newstate-null-inconsistentresultwarnings": {
+			server: &fwserver.Server{
+				InconsistentResultWarnings: true,
+				Provider:                   &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						// Intentionally missing resp.State.Set()
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Missing Resource State After Create",
+						"The Terraform Provider unexpectedly returned no resource state after having no errors in the resource creation. "+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
+							"The resource may have been successfully created, but Terraform is not tracking it. "+
+							"Applying the configuration again with no other action may result in duplicate resource errors.",
+					),
+				},
+				NewState: testEmptyState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-newstate-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -506,6 +634,139 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-semantic-equality-normalizestate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchemaWithSemanticEquals,
+				},
+				ResourceSchema: testSchemaWithSemanticEquals,
+				Resource: &testprovider.ResourceWithNormalizeState{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.State.Raw = req.Plan.Raw
+						},
+					},
+					NormalizeStateMethod: func(ctx context.Context, req resource.NormalizeStateRequest, resp *resource.NormalizeStateResponse) {
+						// This value should not be overwritten back to the plan value.
+						resp.State.Raw = tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+							"test_computed": tftypes.NewValue(tftypes.String, nil),
+							"test_required": tftypes.NewValue(tftypes.String, "test-normalized-value"),
+						})
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-normalized-value"),
+					}),
+					Schema: testSchemaWithSemanticEquals,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						// Prevent missing resource state error diagnostic
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+						got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError(
+								"Unexpected req.Private Value",
+								fmt.Sprintf("expected %q, got %q", expected, got),
+							)
+						}
+					},
+				},
+				PlannedPrivate: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+		},
+		"request-private-nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						// Prevent missing resource state error diagnostic
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+						got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if got != nil {
+							resp.Diagnostics.AddError(
+								"Unexpected req.Private Value",
+								fmt.Sprintf("expected nil, got %q", got),
+							)
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
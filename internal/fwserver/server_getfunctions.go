@@ -23,6 +23,8 @@ type GetFunctionsResponse struct {
 
 // GetFunctions implements the framework server GetFunctions RPC.
 func (s *Server) GetFunctions(ctx context.Context, req *GetFunctionsRequest, resp *GetFunctionsResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	resp.FunctionDefinitions = map[string]function.Definition{}
 
 	functionDefinitions, diags := s.FunctionDefinitions(ctx)
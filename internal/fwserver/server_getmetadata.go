@@ -46,6 +46,8 @@ type ResourceMetadata struct {
 
 // GetMetadata implements the framework server GetMetadata RPC.
 func (s *Server) GetMetadata(ctx context.Context, req *GetMetadataRequest, resp *GetMetadataResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	resp.DataSources = []DataSourceMetadata{}
 	resp.Functions = []FunctionMetadata{}
 	resp.Resources = []ResourceMetadata{}
@@ -29,11 +29,13 @@ type GetProviderSchemaResponse struct {
 
 // GetProviderSchema implements the framework server GetProviderSchema RPC.
 func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRequest, resp *GetProviderSchemaResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	resp.ServerCapabilities = s.ServerCapabilities()
 
 	providerSchema, diags := s.ProviderSchema(ctx)
 
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics = diag.Merge(resp.Diagnostics, diags)
 
 	if diags.HasError() {
 		return
@@ -43,7 +45,7 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 
 	providerMetaSchema, diags := s.ProviderMetaSchema(ctx)
 
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics = diag.Merge(resp.Diagnostics, diags)
 
 	if diags.HasError() {
 		return
@@ -53,7 +55,7 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 
 	resourceSchemas, diags := s.ResourceSchemas(ctx)
 
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics = diag.Merge(resp.Diagnostics, diags)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -63,7 +65,7 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 
 	dataSourceSchemas, diags := s.DataSourceSchemas(ctx)
 
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics = diag.Merge(resp.Diagnostics, diags)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -73,7 +75,7 @@ func (s *Server) GetProviderSchema(ctx context.Context, req *GetProviderSchemaRe
 
 	functions, diags := s.FunctionDefinitions(ctx)
 
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics = diag.Merge(resp.Diagnostics, diags)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -177,6 +177,55 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"datasourceschemas-required-computed-attribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+						return []func() datasource.DataSource{
+							func() datasource.DataSource {
+								return &testprovider.DataSource{
+									SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+										resp.Schema = datasourceschema.Schema{
+											Attributes: map[string]datasourceschema.Attribute{
+												"test_computed": datasourceschema.StringAttribute{
+													Computed: true,
+												},
+												"test_required": datasourceschema.StringAttribute{
+													Computed: true,
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+										resp.TypeName = "test_data_source"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Provider:        providerschema.Schema{},
+				ResourceSchemas: map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test_required\" is both Required and Computed. "+
+							"Required attributes must be configured and cannot be set by the provider. Use Optional and Computed instead to allow the provider to set a value when it is not configured.",
+					),
+				},
+			},
+		},
 		"datasourceschemas-duplicate-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
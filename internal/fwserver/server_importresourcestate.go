@@ -46,6 +46,8 @@ type ImportResourceStateResponse struct {
 
 // ImportResourceState implements the framework server ImportResourceState RPC.
 func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
@@ -122,6 +124,8 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	resp.Diagnostics.Append(SchemaImportedComputedValues(ctx, importResp.State.Schema, req.ID, importResp.State.Raw)...)
+
 	private := &privatestate.Data{}
 
 	if importResp.Private != nil {
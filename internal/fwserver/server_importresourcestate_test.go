@@ -6,6 +6,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +27,7 @@ func TestServerImportResourceState(t *testing.T) {
 
 	testType := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
 			"id":       tftypes.String,
 			"optional": tftypes.String,
 			"required": tftypes.String,
@@ -33,12 +35,14 @@ func TestServerImportResourceState(t *testing.T) {
 	}
 
 	testEmptyStateValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"computed": tftypes.NewValue(tftypes.String, nil),
 		"id":       tftypes.NewValue(tftypes.String, nil),
 		"optional": tftypes.NewValue(tftypes.String, nil),
 		"required": tftypes.NewValue(tftypes.String, nil),
 	})
 
 	testStateValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"computed": tftypes.NewValue(tftypes.String, nil),
 		"id":       tftypes.NewValue(tftypes.String, "test-id"),
 		"optional": tftypes.NewValue(tftypes.String, nil),
 		"required": tftypes.NewValue(tftypes.String, nil),
@@ -46,6 +50,9 @@ func TestServerImportResourceState(t *testing.T) {
 
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"computed": schema.StringAttribute{
+				Computed: true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -245,6 +252,132 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-importedresources-computed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("computed"), "test-computed-value")...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("optional"), "test-optional-value")...)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("computed"),
+						"Computed Attribute Set During Import",
+						"The resource ImportState method set a known value for an attribute which is only computed. "+
+							"Terraform refreshes the imported resource with the Read method, which should set computed attribute values instead. "+
+							"Values set during import may be inconsistent with the remote system, which may cause unexpected differences.\n\n"+
+							"This is likely an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+								"id":       tftypes.NewValue(tftypes.String, "test-id"),
+								"optional": tftypes.NewValue(tftypes.String, "test-optional-value"),
+								"required": tftypes.NewValue(tftypes.String, nil),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"response-importedresources-computed-passthrough": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughID(ctx, path.Root("computed"), req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"computed": tftypes.NewValue(tftypes.String, "test-id"),
+								"id":       tftypes.NewValue(tftypes.String, nil),
+								"optional": tftypes.NewValue(tftypes.String, nil),
+								"required": tftypes.NewValue(tftypes.String, nil),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"response-importedresources-computed-multiple-part-id": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id-part-one,test-id-part-two",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						idParts := strings.Split(req.ID, ",")
+
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("computed"), idParts[0])...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("required"), idParts[1])...)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("computed"),
+						"Computed Attribute Set During Import",
+						"The resource ImportState method set a known value for an attribute which is only computed. "+
+							"Terraform refreshes the imported resource with the Read method, which should set computed attribute values instead. "+
+							"Values set during import may be inconsistent with the remote system, which may cause unexpected differences.\n\n"+
+							"This is likely an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"computed": tftypes.NewValue(tftypes.String, "test-id-part-one"),
+								"id":       tftypes.NewValue(tftypes.String, "test-id-part-one,test-id-part-two"),
+								"optional": tftypes.NewValue(tftypes.String, nil),
+								"required": tftypes.NewValue(tftypes.String, "test-id-part-two"),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
 		"response-importedresources-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -299,6 +432,45 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"importstate-invalid-id": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "malformed id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(_ context.Context, _ resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "")
+						},
+					},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						if strings.Contains(req.ID, " ") {
+							resp.Diagnostics.AddAttributeError(
+								path.Root("id"),
+								"Invalid Import Identifier",
+								fmt.Sprintf("Expected import identifier without spaces. Got: %q", req.ID),
+							)
+
+							return
+						}
+
+						resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("id"),
+						"Invalid Import Identifier",
+						"Expected import identifier without spaces. Got: \"malformed id\"",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
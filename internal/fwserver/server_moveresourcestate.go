@@ -77,6 +77,8 @@ type MoveResourceStateResponse struct {
 
 // MoveResourceState implements the framework server MoveResourceState RPC.
 func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRequest, resp *MoveResourceStateResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
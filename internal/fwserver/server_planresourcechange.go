@@ -46,10 +46,18 @@ type PlanResourceChangeResponse struct {
 
 // PlanResourceChange implements the framework server PlanResourceChange RPC.
 func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+	s.logState(ctx, "request", req.PriorState)
+	s.logPlan(ctx, "request", req.ProposedNewState)
+
+	defer func() { s.logState(ctx, "response", resp.PlannedState) }()
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -292,12 +300,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 	// If this was a destroy resource plan, ensure the plan remained null.
 	if req.ProposedNewState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(s.inconsistentResultDiag(
 			"Unexpected Planned Resource State on Destroy",
 			"The Terraform Provider unexpectedly returned resource state data when the resource was planned for destruction. "+
 				"This is always an issue in the Terraform Provider and should be reported to the provider developers.\n\n"+
 				"Ensure all resource plan modifiers do not attempt to change resource plan data from being a null value if the request plan is a null value.",
-		)
+		))
 	}
 }
 
@@ -32,10 +32,16 @@ type ReadDataSourceResponse struct {
 
 // ReadDataSource implements the framework server ReadDataSource RPC.
 func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest, resp *ReadDataSourceResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+
+	defer func() { s.logState(ctx, "response", resp.State) }()
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -33,10 +33,16 @@ type ReadResourceResponse struct {
 
 // ReadResource implements the framework server ReadResource RPC.
 func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, resp *ReadResourceResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	s.logState(ctx, "request", req.CurrentState)
+
+	defer func() { s.logState(ctx, "response", resp.NewState) }()
+
 	if req.CurrentState == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Read Request",
@@ -116,6 +122,10 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	if req.CurrentState.Schema != nil {
+		resp.Diagnostics.Append(SchemaMustSetOnRead(ctx, req.CurrentState.Schema, req.CurrentState.Raw, resp.NewState.Raw)...)
+	}
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionState,
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -86,6 +87,18 @@ func TestServerReadResource(t *testing.T) {
 		},
 	}
 
+	testSchemaWithMustSetOnRead := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed:      true,
+				MustSetOnRead: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
 	testConfig := &tfsdk.Config{
 		Raw:    testCurrentStateValue,
 		Schema: testSchema,
@@ -429,6 +442,143 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-state-must-set-on-read": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testCurrentStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-newstate-value")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testNewStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-must-set-on-read-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testCurrentStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						// Intentionally does not refresh test_computed.
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test_computed"),
+						"Computed Attribute Not Refreshed",
+						"The resource Read method did not refresh the value of an attribute which must be set on every read. "+
+							"The value is null or unknown, which may cause Terraform to show unexpected differences. "+
+							"This is likely an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw:    testCurrentStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-must-set-on-read-unchanged": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testNewStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						// Intentionally does not refresh test_computed.
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_required"), "test-currentstate-value")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testNewStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-must-set-on-read-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testCurrentStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), types.StringUnknown())...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test_computed"),
+						"Computed Attribute Not Refreshed",
+						"The resource Read method did not refresh the value of an attribute which must be set on every read. "+
+							"The value is null or unknown, which may cause Terraform to show unexpected differences. "+
+							"This is likely an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-currentstate-value"),
+					}),
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-state-must-set-on-read-removeresource": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: &tfsdk.State{
+					Raw:    testNewStateValue,
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.State.RemoveResource(ctx)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchemaWithMustSetOnRead,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-state-semantic-equality": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
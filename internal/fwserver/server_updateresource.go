@@ -100,6 +100,13 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		updateResp.State = *req.PriorState
 	}
 
+	if s.UpdateNewStateFromPlan && req.PlannedState != nil {
+		updateResp.State = tfsdk.State{
+			Schema: req.PlannedState.Schema,
+			Raw:    req.PlannedState.Raw.Copy(),
+		}
+	}
+
 	if req.ProviderMeta != nil {
 		updateReq.ProviderMeta = *req.ProviderMeta
 	}
@@ -126,11 +133,11 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 	resp.NewState = &updateResp.State
 
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(s.inconsistentResultDiag(
 			"Missing Resource State After Update",
 			"The Terraform Provider unexpectedly returned no resource state after having no errors in the resource update. "+
 				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
-		)
+		))
 	}
 
 	if updateResp.Private != nil {
@@ -169,11 +176,13 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
-	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
-		return
-	}
+	if !semanticEqualityResp.NewData.TerraformValue.Equal(resp.NewState.Raw) {
+		logging.FrameworkDebug(ctx, "State updated due to semantic equality")
 
-	logging.FrameworkDebug(ctx, "State updated due to semantic equality")
+		resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	}
 
-	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
+	// Normalize the new state after semantic equality, so semantic equality
+	// does not replace normalized values with planned values.
+	resp.Diagnostics.Append(ResourceNormalizeState(ctx, req.Resource, updateReq.Config, updateReq.Plan, resp.NewState)...)
 }
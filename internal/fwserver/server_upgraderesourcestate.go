@@ -45,10 +45,14 @@ type UpgradeResourceStateResponse struct {
 
 // UpgradeResourceState implements the framework server UpgradeResourceState RPC.
 func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil {
 		return
 	}
 
+	defer func() { s.logState(ctx, "response", resp.UpgradedState) }()
+
 	// No UpgradedState to return. This could return an error diagnostic about
 	// the odd scenario, but seems best to allow Terraform CLI to handle the
 	// situation itself in case it might be expected behavior.
@@ -27,10 +27,14 @@ type ValidateDataSourceConfigResponse struct {
 
 // ValidateDataSourceConfig implements the framework server ValidateDataSourceConfig RPC.
 func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+
 	if dataSourceWithConfigure, ok := req.DataSource.(datasource.DataSourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
 
@@ -27,10 +27,14 @@ type ValidateProviderConfigResponse struct {
 
 // ValidateProviderConfig implements the framework server ValidateProviderConfig RPC.
 func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+
 	vpcReq := provider.ValidateConfigRequest{
 		Config: *req.Config,
 	}
@@ -27,10 +27,14 @@ type ValidateResourceConfigResponse struct {
 
 // ValidateResourceConfig implements the framework server ValidateResourceConfig RPC.
 func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
+	defer s.filterDiagnostics(ctx, &resp.Diagnostics)
+
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	s.logConfig(ctx, "request", req.Config)
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
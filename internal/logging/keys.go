@@ -15,6 +15,10 @@ const (
 	// as parent.0.child in this project.
 	KeyAttributePath = "tf_attribute_path"
 
+	// Representation of decoded configuration, plan, or state data, which
+	// must never contain sensitive values.
+	KeyData = "tf_data"
+
 	// The type of data source being operated on, such as "archive_file"
 	KeyDataSourceType = "tf_data_source_type"
 
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"fmt"
+)
+
+// RedactedValue is logged in place of values which must never be logged,
+// such as the values of sensitive attributes.
+const RedactedValue = "(sensitive value)"
+
+// Value returns the log representation of an attribute value. Sensitive
+// values are always replaced with RedactedValue. The value is expected to be a
+// fmt.Stringer, so the logging package does not need to import value handling
+// code.
+//
+// Framework logging should prefer logging attribute paths and value types
+// instead of values. This function must be used if a value is ever logged.
+func Value(value fmt.Stringer, sensitive bool) string {
+	if sensitive {
+		return RedactedValue
+	}
+
+	if value == nil {
+		return "<nil>"
+	}
+
+	return value.String()
+}
+
+// ValueType returns the log representation of the Go type of a value, such
+// as basetypes.StringValue, which never contains the value itself.
+func ValueType(value any) string {
+	return fmt.Sprintf("%T", value)
+}
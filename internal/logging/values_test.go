@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     tftypes.Value
+		sensitive bool
+		expected  string
+	}{
+		"not-sensitive": {
+			value:    tftypes.NewValue(tftypes.String, "test-value"),
+			expected: `tftypes.String<"test-value">`,
+		},
+		"sensitive": {
+			value:     tftypes.NewValue(tftypes.String, "test-value"),
+			sensitive: true,
+			expected:  "(sensitive value)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := logging.Value(testCase.value, testCase.sensitive)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueType(t *testing.T) {
+	t.Parallel()
+
+	got := logging.ValueType(tftypes.NewValue(tftypes.String, "test-value"))
+	expected := "tftypes.Value"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -104,6 +104,32 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &tfprotov5.ConfigureProviderResponse{},
 		},
+		"request-config-missing": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							var got types.String
+
+							resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+							if resp.Diagnostics.HasError() {
+								return
+							}
+
+							if !got.IsNull() {
+								resp.Diagnostics.AddError("Incorrect req.Config", "expected null, got "+got.String())
+							}
+						},
+					},
+				},
+			},
+			request:          &tfprotov5.ConfigureProviderRequest{},
+			expectedResponse: &tfprotov5.ConfigureProviderResponse{},
+		},
 		"request-terraformversion": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
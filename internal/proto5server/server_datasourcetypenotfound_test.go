@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+// TestServer_dataSourceTypeNotFound verifies all data source RPCs return a
+// consistent diagnostic when the request type name is not registered.
+func TestServer_dataSourceTypeNotFound(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test_required": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = "test_data_source"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	expectedDiagnostics := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Data Source Type Not Found",
+			Detail:   "No data source type named \"test_unknown\" was found in the provider.",
+		},
+	}
+
+	testCases := map[string]func(context.Context) ([]*tfprotov5.Diagnostic, error){
+		"ReadDataSource": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ReadDataSource(ctx, &tfprotov5.ReadDataSourceRequest{
+				Config:   testValue,
+				TypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"ValidateDataSourceConfig": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ValidateDataSourceConfig(ctx, &tfprotov5.ValidateDataSourceConfigRequest{
+				Config:   testValue,
+				TypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
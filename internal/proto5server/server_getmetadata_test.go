@@ -69,6 +69,86 @@ func TestServerGetMetadata(t *testing.T) {
 				},
 			},
 		},
+		"datasources-and-resources-no-schemas": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							return []func() datasource.DataSource{
+								func() datasource.DataSource {
+									return &testprovider.DataSource{
+										MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+											resp.TypeName = "test_data_source1"
+										},
+										SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, _ *datasource.SchemaResponse) {
+											panic("GetMetadata should not call DataSource Schema")
+										},
+									}
+								},
+								func() datasource.DataSource {
+									return &testprovider.DataSource{
+										MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+											resp.TypeName = "test_data_source2"
+										},
+										SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, _ *datasource.SchemaResponse) {
+											panic("GetMetadata should not call DataSource Schema")
+										},
+									}
+								},
+							}
+						},
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource1"
+										},
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+											panic("GetMetadata should not call Resource Schema")
+										},
+									}
+								},
+								func() resource.Resource {
+									return &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource2"
+										},
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+											panic("GetMetadata should not call Resource Schema")
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.GetMetadataRequest{},
+			expectedResponse: &tfprotov5.GetMetadataResponse{
+				DataSources: []tfprotov5.DataSourceMetadata{
+					{
+						TypeName: "test_data_source1",
+					},
+					{
+						TypeName: "test_data_source2",
+					},
+				},
+				Functions: []tfprotov5.FunctionMetadata{},
+				Resources: []tfprotov5.ResourceMetadata{
+					{
+						TypeName: "test_resource1",
+					},
+					{
+						TypeName: "test_resource2",
+					},
+				},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"datasources-duplicate-type-name": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -364,6 +364,78 @@ func TestServerReadResource(t *testing.T) {
 				NewState: &testNewStateRemovedDynamicValue,
 			},
 		},
+		"response-state-removeresource-diagnostics": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+											resp.Diagnostics.AddWarning("warning summary", "warning detail")
+											resp.State.RemoveResource(ctx)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityWarning,
+						Summary:  "warning summary",
+						Detail:   "warning detail",
+					},
+				},
+				NewState: &testNewStateRemovedDynamicValue,
+			},
+		},
+		"response-state-unmodified": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										ReadMethod: func(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+											// Intentionally empty, should preserve the current state.
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ReadResourceRequest{
+				CurrentState: testCurrentStateValue,
+				TypeName:     "test_resource",
+			},
+			expectedResponse: &tfprotov5.ReadResourceResponse{
+				NewState: testCurrentStateValue,
+			},
+		},
 		"response-private": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
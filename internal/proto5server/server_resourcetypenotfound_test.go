@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// TestServer_resourceTypeNotFound verifies all managed resource RPCs return a
+// consistent diagnostic when the request type name is not registered.
+func TestServer_resourceTypeNotFound(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"test_required": schema.StringAttribute{
+												Required: true,
+											},
+										},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	expectedDiagnostics := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Resource Type Not Found",
+			Detail:   "No resource type named \"test_unknown\" was found in the provider.",
+		},
+	}
+
+	testCases := map[string]func(context.Context) ([]*tfprotov5.Diagnostic, error){
+		"ApplyResourceChange": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+				Config:       testValue,
+				PlannedState: testValue,
+				PriorState:   testValue,
+				TypeName:     "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"ImportResourceState": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ImportResourceState(ctx, &tfprotov5.ImportResourceStateRequest{
+				ID:       "test-id",
+				TypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"MoveResourceState": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+				SourceProviderAddress: "registry.terraform.io/hashicorp/test",
+				SourceState: &tfprotov5.RawState{
+					JSON: []byte(`{"test_required":"test-value"}`),
+				},
+				SourceTypeName: "test_resource",
+				TargetTypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"PlanResourceChange": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				Config:           testValue,
+				PriorState:       testValue,
+				ProposedNewState: testValue,
+				TypeName:         "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"ReadResource": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+				CurrentState: testValue,
+				TypeName:     "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"UpgradeResourceState": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				RawState: &tfprotov5.RawState{
+					JSON: []byte(`{"test_required":"test-value"}`),
+				},
+				TypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+		"ValidateResourceTypeConfig": func(ctx context.Context) ([]*tfprotov5.Diagnostic, error) {
+			resp, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+				Config:   testValue,
+				TypeName: "test_unknown",
+			})
+
+			return resp.Diagnostics, err
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
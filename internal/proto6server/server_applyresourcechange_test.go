@@ -4,6 +4,7 @@
 package proto6server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -194,6 +197,69 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"create-request-plannedstate-missing-attribute": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+										CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: no call, Got: Create")
+										},
+										DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: no call, Got: Delete")
+										},
+										UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+											resp.Diagnostics.AddError("Unexpected Method Call", "Expected: no call, Got: Update")
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PlannedState: testNewDynamicValue(t,
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_required": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					},
+				),
+				PriorState: &testEmptyDynamicValue,
+				TypeName:   "test_resource",
+			},
+			expectedResponse: &tfprotov6.ApplyResourceChangeResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Convert Plan",
+						Detail: "An unexpected error was encountered when converting the plan from the protocol type. " +
+							"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+							"Please report this to the provider developer:\n\n" +
+							"Unable to unmarshal DynamicValue: error decoding object; expected 2 attributes, got 1\n\n" +
+							"Expected Type: types.ObjectType[\"test_computed\":basetypes.StringType, \"test_required\":basetypes.StringType]\n" +
+							"Actual Type: tftypes.Object[\"test_required\":tftypes.String]",
+					},
+				},
+			},
+		},
 		"create-request-providermeta": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -1433,3 +1499,245 @@ func TestServerApplyResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerApplyResourceChange_privateRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testEmptyDynamicValue, _ := tfprotov6.NewDynamicValue(testSchemaType, tftypes.NewValue(testSchemaType, nil))
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	type testSchemaData struct {
+		TestComputed types.String `tfsdk:"test_computed"`
+		TestRequired types.String `tfsdk:"test_required"`
+	}
+
+	testPriorValue := []byte(`{"prior": true}`)
+	testPlannedValue := []byte(`{"planned": true}`)
+	testAppliedValue := []byte(`{"applied": true}`)
+
+	// expectPrivateKey adds an error diagnostic if the private state data
+	// value of the key does not match the expected value.
+	expectPrivateKey := func(ctx context.Context, diags *diag.Diagnostics, private *privatestate.ProviderData, key string, expected []byte) {
+		got, getKeyDiags := private.GetKey(ctx, key)
+
+		diags.Append(getKeyDiags...)
+
+		if !bytes.Equal(got, expected) {
+			diags.AddError(
+				"Unexpected Private Value",
+				fmt.Sprintf("key %q: expected %q, got %q", key, expected, got),
+			)
+		}
+	}
+
+	testCases := map[string]struct {
+		priorState           *tfprotov6.DynamicValue
+		priorPrivate         []byte
+		modifyPlanSetsKey    bool
+		applySetsKey         bool
+		expectedPriorValue   []byte
+		expectedPlannedValue []byte
+		expectedAppliedValue []byte
+		expectedPrivate      []byte
+	}{
+		"create-no-private": {
+			priorState: &testEmptyDynamicValue,
+		},
+		"create": {
+			priorState:           &testEmptyDynamicValue,
+			modifyPlanSetsKey:    true,
+			applySetsKey:         true,
+			expectedPlannedValue: testPlannedValue,
+			expectedAppliedValue: testAppliedValue,
+			expectedPrivate: privatestate.MustMarshalToJson(map[string][]byte{
+				"applied": testAppliedValue,
+				"planned": testPlannedValue,
+			}),
+		},
+		"create-plan-only": {
+			priorState:           &testEmptyDynamicValue,
+			modifyPlanSetsKey:    true,
+			expectedPlannedValue: testPlannedValue,
+			expectedPrivate: privatestate.MustMarshalToJson(map[string][]byte{
+				"planned": testPlannedValue,
+			}),
+		},
+		"update-no-private": {
+			priorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+				"test_required": tftypes.NewValue(tftypes.String, "test-prior-value"),
+			}),
+		},
+		"update-empty-private": {
+			priorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+				"test_required": tftypes.NewValue(tftypes.String, "test-prior-value"),
+			}),
+			priorPrivate: []byte{},
+		},
+		"update": {
+			priorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+				"test_required": tftypes.NewValue(tftypes.String, "test-prior-value"),
+			}),
+			priorPrivate: privatestate.MustMarshalToJson(map[string][]byte{
+				"prior": testPriorValue,
+			}),
+			modifyPlanSetsKey:    true,
+			applySetsKey:         true,
+			expectedPriorValue:   testPriorValue,
+			expectedPlannedValue: testPlannedValue,
+			expectedAppliedValue: testAppliedValue,
+			expectedPrivate: privatestate.MustMarshalToJson(map[string][]byte{
+				"applied": testAppliedValue,
+				"planned": testPlannedValue,
+				"prior":   testPriorValue,
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			applyMethod := func(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State, reqPrivate *privatestate.ProviderData, respPrivate *privatestate.ProviderData, diags *diag.Diagnostics) {
+				var data testSchemaData
+
+				diags.Append(plan.Get(ctx, &data)...)
+
+				data.TestComputed = types.StringValue("test-computed-value")
+
+				diags.Append(state.Set(ctx, &data)...)
+
+				expectPrivateKey(ctx, diags, reqPrivate, "prior", testCase.expectedPriorValue)
+				expectPrivateKey(ctx, diags, reqPrivate, "planned", testCase.expectedPlannedValue)
+
+				if testCase.applySetsKey {
+					diags.Append(respPrivate.SetKey(ctx, "applied", testAppliedValue)...)
+				}
+			}
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithModifyPlan{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+											CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+												applyMethod(ctx, req.Plan, &resp.State, req.Private, resp.Private, &resp.Diagnostics)
+											},
+											DeleteMethod: func(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+												resp.Diagnostics.AddError("Unexpected Method Call", "Expected: Create or Update, Got: Delete")
+											},
+											ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+												expectPrivateKey(ctx, &resp.Diagnostics, req.Private, "prior", testCase.expectedPriorValue)
+												expectPrivateKey(ctx, &resp.Diagnostics, req.Private, "planned", testCase.expectedPlannedValue)
+												expectPrivateKey(ctx, &resp.Diagnostics, req.Private, "applied", testCase.expectedAppliedValue)
+											},
+											UpdateMethod: func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+												applyMethod(ctx, req.Plan, &resp.State, req.Private, resp.Private, &resp.Diagnostics)
+											},
+										},
+										ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+											expectPrivateKey(ctx, &resp.Diagnostics, req.Private, "prior", testCase.expectedPriorValue)
+
+											if testCase.modifyPlanSetsKey {
+												resp.Diagnostics.Append(resp.Private.SetKey(ctx, "planned", testPlannedValue)...)
+											}
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			}
+
+			config := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_computed": tftypes.NewValue(tftypes.String, nil),
+				"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			})
+
+			planResp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				Config:           config,
+				PriorPrivate:     testCase.priorPrivate,
+				PriorState:       testCase.priorState,
+				ProposedNewState: config,
+				TypeName:         "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected PlanResourceChange error: %s", err)
+			}
+
+			if diff := cmp.Diff(planResp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				t.Fatalf("unexpected PlanResourceChange diagnostics: %s", diff)
+			}
+
+			applyResp, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config:         config,
+				PlannedPrivate: planResp.PlannedPrivate,
+				PlannedState:   planResp.PlannedState,
+				PriorState:     testCase.priorState,
+				TypeName:       "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected ApplyResourceChange error: %s", err)
+			}
+
+			if diff := cmp.Diff(applyResp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				t.Fatalf("unexpected ApplyResourceChange diagnostics: %s", diff)
+			}
+
+			if diff := cmp.Diff(applyResp.Private, testCase.expectedPrivate); diff != "" {
+				t.Errorf("unexpected ApplyResourceChange private difference: %s", diff)
+			}
+
+			readResp, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: applyResp.NewState,
+				Private:      applyResp.Private,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected ReadResource error: %s", err)
+			}
+
+			if diff := cmp.Diff(readResp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				t.Fatalf("unexpected ReadResource diagnostics: %s", diff)
+			}
+
+			if diff := cmp.Diff(readResp.Private, testCase.expectedPrivate); diff != "" {
+				t.Errorf("unexpected ReadResource private difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// TestServer_concurrentSchemaCache verifies the framework server schema caching
+// is safe for concurrent RPCs, as Terraform may call them concurrently. Run
+// with the -race flag to detect data races.
+func TestServer_concurrentSchemaCache(t *testing.T) {
+	t.Parallel()
+
+	const concurrency = 50
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testEmptyDynamicValue, _ := tfprotov6.NewDynamicValue(testSchemaType, tftypes.NewValue(testSchemaType, nil))
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	var providerSchemaCalls, resourceSchemaCalls atomic.Int64
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					providerSchemaCalls.Add(1)
+
+					resp.Schema = providerschema.Schema{}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resourceSchemaCalls.Add(1)
+
+									// Widen the window for concurrent RPCs to
+									// call Schema before the result is cached.
+									time.Sleep(10 * time.Millisecond)
+
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource"
+								},
+								CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+									resp.State.Raw = req.Plan.Raw
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+
+	errs := make(chan string, concurrency*3)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+			if err != nil {
+				errs <- "GetProviderSchema error: " + err.Error()
+
+				return
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				errs <- "GetProviderSchema diagnostics: " + diff
+			}
+
+			if _, ok := resp.ResourceSchemas["test_resource"]; !ok {
+				errs <- "GetProviderSchema missing test_resource schema"
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+				Config:   testValue,
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				errs <- "ValidateResourceConfig error: " + err.Error()
+
+				return
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				errs <- "ValidateResourceConfig diagnostics: " + diff
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+				Config:       testValue,
+				PlannedState: testValue,
+				PriorState:   &testEmptyDynamicValue,
+				TypeName:     "test_resource",
+			})
+
+			if err != nil {
+				errs <- "ApplyResourceChange error: " + err.Error()
+
+				return
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				errs <- "ApplyResourceChange diagnostics: " + diff
+			}
+
+			if diff := cmp.Diff(resp.NewState, testValue); diff != "" {
+				errs <- "ApplyResourceChange new state: " + diff
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// The provider schema is cached across all RPCs.
+	if got := providerSchemaCalls.Load(); got != 1 {
+		t.Errorf("expected 1 provider Schema call, got: %d", got)
+	}
+
+	// GetProviderSchema does not cache resource schemas, while all other
+	// resource RPCs share a single cached schema.
+	if got, expected := resourceSchemaCalls.Load(), int64(concurrency+1); got != expected {
+		t.Errorf("expected %d resource Schema calls, got: %d", expected, got)
+	}
+}
+
+// TestServer_concurrentSchemaCacheTypeNames verifies a long running resource
+// Schema method call does not block RPCs for other resource type names.
+func TestServer_concurrentSchemaCacheTypeNames(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	slowSchemaStarted := make(chan struct{})
+	slowSchemaRelease := make(chan struct{})
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.Provider{
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									close(slowSchemaStarted)
+									<-slowSchemaRelease
+
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource_slow"
+								},
+							}
+						},
+						func() resource.Resource {
+							return &testprovider.Resource{
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = testSchema
+								},
+								MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = "test_resource_fast"
+								},
+							}
+						},
+					}
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	slowDone := make(chan struct{})
+
+	go func() {
+		defer close(slowDone)
+
+		resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			Config:   testValue,
+			TypeName: "test_resource_slow",
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+
+			return
+		}
+
+		if diff := cmp.Diff(resp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+			t.Errorf("unexpected slow resource diagnostics difference: %s", diff)
+		}
+	}()
+
+	<-slowSchemaStarted
+
+	fastDone := make(chan struct{})
+
+	go func() {
+		defer close(fastDone)
+
+		resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			Config:   testValue,
+			TypeName: "test_resource_fast",
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+
+			return
+		}
+
+		if diff := cmp.Diff(resp.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+			t.Errorf("unexpected fast resource diagnostics difference: %s", diff)
+		}
+	}()
+
+	select {
+	case <-fastDone:
+	case <-time.After(5 * time.Second):
+		t.Error("expected fast resource RPC to complete while slow resource Schema method is running")
+	}
+
+	close(slowSchemaRelease)
+
+	<-slowDone
+	<-fastDone
+}
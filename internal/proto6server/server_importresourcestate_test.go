@@ -145,6 +145,41 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-not-implemented": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ImportResourceStateRequest{
+				ID:       "test-id",
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov6.ImportResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Resource Import Not Implemented",
+						Detail:   "This resource does not support import. Please contact the provider developer for additional information.",
+					},
+				},
+			},
+		},
 		"response-importedresources": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -9,12 +9,16 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -344,6 +348,54 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"create-response-plannedprivate": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithModifyPlan{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = testSchema
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+										},
+										ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+											resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKey", []byte(`{"providerKey": "provider value"}`))...)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				ProposedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				TypeName:   "test_resource",
+			},
+			expectedResponse: &tfprotov6.PlanResourceChangeResponse{
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PlannedPrivate: privatestate.MustMarshalToJson(map[string][]byte{
+					"providerKey": []byte(`{"providerKey": "provider value"}`),
+				}),
+			},
+		},
 		"create-response-requiresreplace": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -1055,3 +1107,115 @@ func TestServerPlanResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPlanResourceChange_suppressDifferences(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_case":       tftypes.String,
+			"test_whitespace": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_case": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("TEST-VALUE"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.SuppressCaseDifferences(),
+				},
+			},
+			"test_whitespace": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("test value"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.SuppressWhitespaceDifferences(),
+				},
+			},
+		},
+	}
+
+	testPriorState := testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+		"test_case":       tftypes.NewValue(tftypes.String, "test-value"),
+		"test_whitespace": tftypes.NewValue(tftypes.String, " test  value\n"),
+	})
+
+	testCases := map[string]struct {
+		config               map[string]tftypes.Value
+		expectedPlannedState *tfprotov6.DynamicValue
+	}{
+		"unconfigured": {
+			config: map[string]tftypes.Value{
+				"test_case":       tftypes.NewValue(tftypes.String, nil),
+				"test_whitespace": tftypes.NewValue(tftypes.String, nil),
+			},
+			expectedPlannedState: testPriorState,
+		},
+		// Terraform requires configured values to be preserved in the plan,
+		// so the differences are not suppressed.
+		"configured": {
+			config: map[string]tftypes.Value{
+				"test_case":       tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+				"test_whitespace": tftypes.NewValue(tftypes.String, "test value"),
+			},
+			expectedPlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_case":       tftypes.NewValue(tftypes.String, "TEST-VALUE"),
+				"test_whitespace": tftypes.NewValue(tftypes.String, "test value"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			}
+
+			testConfig := testNewDynamicValue(t, testSchemaType, testCase.config)
+
+			got, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				Config:           testConfig,
+				PriorState:       testPriorState,
+				ProposedNewState: testConfig,
+				TypeName:         "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got.Diagnostics, []*tfprotov6.Diagnostic(nil)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.PlannedState, testCase.expectedPlannedState); diff != "" {
+				t.Errorf("unexpected planned state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -54,6 +54,11 @@ func TestServerReadDataSource(t *testing.T) {
 		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
 	})
 
+	testStateComputedFromRequiredDynamicValue := testNewDynamicValue(t, testType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, "computed-from-test-config-value"),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
 	testSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -141,6 +146,41 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testConfigDynamicValue,
 			},
 		},
+		"request-providermeta-no-metaschema": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							return []func() datasource.DataSource{
+								func() datasource.DataSource {
+									return &testprovider.DataSource{
+										SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+											resp.Schema = schema.Schema{}
+										},
+										MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+											resp.TypeName = "test_data_source"
+										},
+										ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+											if req.ProviderMeta.Schema != nil || !req.ProviderMeta.Raw.Equal(tftypes.Value{}) {
+												resp.Diagnostics.AddError("unexpected req.ProviderMeta value", req.ProviderMeta.Raw.String())
+											}
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ReadDataSourceRequest{
+				Config:       testEmptyDynamicValue,
+				ProviderMeta: testProviderMetaDynamicValue,
+				TypeName:     "test_data_source",
+			},
+			expectedResponse: &tfprotov6.ReadDataSourceResponse{
+				State: testEmptyDynamicValue,
+			},
+		},
 		"request-providermeta": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -283,6 +323,47 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateDynamicValue,
 			},
 		},
+		"response-state-computed-from-required": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+							return []func() datasource.DataSource{
+								func() datasource.DataSource {
+									return &testprovider.DataSource{
+										SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+											resp.Schema = testSchema
+										},
+										MetadataMethod: func(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+											resp.TypeName = "test_data_source"
+										},
+										ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+											var data struct {
+												TestComputed types.String `tfsdk:"test_computed"`
+												TestRequired types.String `tfsdk:"test_required"`
+											}
+
+											resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+											data.TestComputed = types.StringValue("computed-from-" + data.TestRequired.ValueString())
+
+											resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ReadDataSourceRequest{
+				Config:   testConfigDynamicValue,
+				TypeName: "test_data_source",
+			},
+			expectedResponse: &tfprotov6.ReadDataSourceResponse{
+				State: testStateComputedFromRequiredDynamicValue,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -121,6 +122,36 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testDynamicValue,
 			},
 		},
+		"response-diagnostics-attribute-path": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithValidateConfig{
+						Provider: &testprovider.Provider{
+							SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+								resp.Schema = testSchema
+							},
+						},
+						ValidateConfigMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+							resp.Diagnostics.AddAttributeError(path.Root("test"), "error summary", "error detail")
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ValidateProviderConfigRequest{
+				Config: &testDynamicValue,
+			},
+			expectedResponse: &tfprotov6.ValidateProviderConfigResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity:  tfprotov6.DiagnosticSeverityError,
+						Summary:   "error summary",
+						Detail:    "error detail",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					},
+				},
+				PreparedConfig: &testDynamicValue,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
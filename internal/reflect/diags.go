@@ -45,6 +45,16 @@ func valueFromTerraformErrorDiag(err error, path path.Path) diag.DiagnosticWithP
 	)
 }
 
+func fromTypeMismatchErrorDiag(typ attr.Type, val interface{}, path path.Path) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+			fmt.Sprintf("Path: %s\nExpected Type: %s\nGiven Go Type: %T", path, typ, val),
+	)
+}
+
 type DiagIntoIncompatibleType struct {
 	Val        tftypes.Value
 	TargetType reflect.Type
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// durationType is the reflect.Type of time.Duration.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// isStringDuration returns true if the target is a time.Duration and the
+// Terraform type is a string. time.Duration targets with other types use the
+// default reflection rules for their underlying kind.
+func isStringDuration(target reflect.Type, typ tftypes.Type) bool {
+	return target == durationType && typ.Is(tftypes.String)
+}
+
+// Duration builds a time.Duration from a Terraform string value, which must
+// be in the Go duration string format, such as "5m" or "1h30m".
+//
+// It is meant to be called through `Into`, not directly.
+func Duration(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	d, err := time.ParseDuration(s)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid Duration Value",
+			fmt.Sprintf("The value %q could not be parsed as a duration. Durations must be a number with a unit suffix, such as \"30s\", \"5m\", or \"1h30m\".\n\n", s)+
+				"Error: "+err.Error(),
+		)
+		return target, diags
+	}
+
+	return reflect.ValueOf(d), diags
+}
+
+// FromDuration returns an attr.Value as produced by `typ` from a
+// time.Duration, formatted as a Go duration string.
+//
+// It is meant to be called through FromValue, not directly.
+func FromDuration(ctx context.Context, typ attr.Type, val time.Duration, path path.Path) (attr.Value, diag.Diagnostics) {
+	return FromString(ctx, typ, val.String(), path)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	var d time.Duration
+
+	result, diags := refl.Duration(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "5m"), reflect.ValueOf(d), path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&d).Elem().Set(result)
+	if d != 5*time.Minute {
+		t.Errorf("Expected %s, got %s", 5*time.Minute, d)
+	}
+}
+
+func TestDuration_invalid(t *testing.T) {
+	t.Parallel()
+
+	var d time.Duration
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid Duration Value",
+			"The value \"five minutes\" could not be parsed as a duration. Durations must be a number with a unit suffix, such as \"30s\", \"5m\", or \"1h30m\".\n\n"+
+				"Error: time: invalid duration \"five minutes\"",
+		),
+	}
+
+	_, diags := refl.Duration(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "five minutes"), reflect.ValueOf(d), path.Root("test"))
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromDuration(t *testing.T) {
+	t.Parallel()
+
+	actual, diags := refl.FromValue(context.Background(), types.StringType, 5*time.Minute, path.Empty())
+
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+
+	expected := types.StringValue("5m0s")
+
+	if diff := cmp.Diff(actual, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDurationRoundtrip(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		TTL        time.Duration  `tfsdk:"ttl"`
+		TTLPointer *time.Duration `tfsdk:"ttl_pointer"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"ttl":         types.StringType,
+			"ttl_pointer": types.StringType,
+		},
+	}
+
+	ttlPointer := 90 * time.Second
+	original := testStruct{
+		TTL:        5 * time.Minute,
+		TTLPointer: &ttlPointer,
+	}
+
+	value, diags := refl.FromValue(context.Background(), objectType, original, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expectedValue := types.ObjectValueMust(
+		objectType.AttrTypes,
+		map[string]attr.Value{
+			"ttl":         types.StringValue("5m0s"),
+			"ttl_pointer": types.StringValue("1m30s"),
+		},
+	)
+
+	if diff := cmp.Diff(value, expectedValue); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+
+	tfValue, err := value.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var got testStruct
+
+	diags = refl.Into(context.Background(), objectType, tfValue, &got, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, original); diff != "" {
+		t.Errorf("unexpected struct difference: %s", diff)
+	}
+}
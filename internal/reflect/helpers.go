@@ -9,6 +9,8 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -45,14 +47,31 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
+// structTagsCache contains the successful getStructTags results, keyed by
+// struct reflect.Type. The field name mapping only depends on the struct type,
+// since the mapping is compared against the schema on every call, so the
+// schema is not part of the key. Errors are not cached as they contain the
+// path of the call. Cached maps must not be modified.
+var structTagsCache sync.Map // map[reflect.Type]map[string]int
+
+// validFieldNameRegexp matches valid Terraform field names.
+var validFieldNameRegexp = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
 // getStructTags returns a map of Terraform field names to their position in
-// the tags of the struct `in`. `in` must be a struct.
+// the tags of the struct `in`. `in` must be a struct. The returned map is
+// shared between calls for the same struct type and must not be modified.
 func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]int, error) {
-	tags := map[string]int{}
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
 	}
+	if cached, ok := structTagsCache.Load(typ); ok {
+		//nolint:forcetypeassert // Only map[string]int values are stored
+		return cached.(map[string]int), nil
+	}
+	tags := map[string]int{}
+	// a pointer receiver method set also includes value receiver methods
+	fieldNames, hasFieldNames := reflect.New(typ).Interface().(StructWithFieldNames)
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
 		if field.PkgPath != "" {
@@ -64,6 +83,11 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 			// skip explicitly excluded fields
 			continue
 		}
+		if tag == "" && hasFieldNames {
+			// struct tags are authoritative, so only fall back to the
+			// struct field name conversion when there is no tag
+			tag = fieldNames.TFSDKFieldName(field.Name)
+		}
 		if tag == "" {
 			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
 		}
@@ -76,14 +100,14 @@ func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[str
 		}
 		tags[tag] = i
 	}
+	structTagsCache.Store(typ, tags)
 	return tags, nil
 }
 
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
-	re := regexp.MustCompile("^[a-z][a-z0-9_]*$")
-	return re.MatchString(name)
+	return validFieldNameRegexp.MatchString(name)
 }
 
 // canBeNil returns true if `target`'s type can hold a nil value
@@ -97,3 +121,32 @@ func canBeNil(target reflect.Value) bool {
 		return false
 	}
 }
+
+// SnakeCaseFieldName returns the snake_case form of a Go struct field name,
+// such as "example_attribute" for "ExampleAttribute". Consecutive uppercase
+// letters are treated as a single word, such as "http_endpoint" for
+// "HTTPEndpoint".
+func SnakeCaseFieldName(fieldName string) string {
+	runes := []rune(fieldName)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+					b.WriteRune('_')
+				}
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -4,9 +4,14 @@
 package reflect
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestTrueReflectValue(t *testing.T) {
@@ -118,6 +123,132 @@ func TestIsValidFieldName(t *testing.T) {
 	}
 }
 
+type testGetStructTagsEmbedded struct {
+	Embedded string `tfsdk:"embedded"`
+}
+
+type TestGetStructTagsExportedEmbedded struct {
+	Embedded string `tfsdk:"embedded"`
+}
+
+func TestGetStructTags(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		// Embedded structs are fields named after their type, so
+		// unexported types are skipped like other unexported fields.
+		testGetStructTagsEmbedded         `tfsdk:"unexported_embedded_struct"`
+		TestGetStructTagsExportedEmbedded `tfsdk:"embedded_struct"`
+
+		Ignored    string `tfsdk:"-"`
+		Tagged     string `tfsdk:"tagged"`
+		unexported string //nolint:unused // Verifying unexported fields are skipped
+	}
+
+	type testStructMissingTag struct {
+		Tagged   string `tfsdk:"tagged"`
+		Untagged string
+	}
+
+	testCases := map[string]struct {
+		in          reflect.Value
+		path        path.Path
+		expected    map[string]int
+		expectedErr error
+	}{
+		"struct": {
+			in:   reflect.ValueOf(testStruct{}),
+			path: path.Root("test"),
+			expected: map[string]int{
+				"embedded_struct": 1,
+				"tagged":          3,
+			},
+		},
+		"struct-pointer": {
+			in:   reflect.ValueOf(&testStruct{}),
+			path: path.Root("test"),
+			expected: map[string]int{
+				"embedded_struct": 1,
+				"tagged":          3,
+			},
+		},
+		"missing-tag": {
+			in:          reflect.ValueOf(testStructMissingTag{}),
+			path:        path.Root("test"),
+			expectedErr: fmt.Errorf(`test: need a struct tag for "tfsdk" on Untagged`),
+		},
+		"missing-tag-other-path": {
+			// errors must not be cached, since they contain the path
+			in:          reflect.ValueOf(testStructMissingTag{}),
+			path:        path.Root("other"),
+			expectedErr: fmt.Errorf(`other: need a struct tag for "tfsdk" on Untagged`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Call twice to verify cached results.
+			for i := 0; i < 2; i++ {
+				got, err := getStructTags(context.Background(), testCase.in, testCase.path)
+
+				if err != nil {
+					if testCase.expectedErr == nil {
+						t.Fatalf("expected no error, got: %s", err)
+					}
+
+					if err.Error() != testCase.expectedErr.Error() {
+						t.Fatalf("expected error %q, got: %s", testCase.expectedErr, err)
+					}
+				}
+
+				if err == nil && testCase.expectedErr != nil {
+					t.Fatalf("got no error, expected: %s", testCase.expectedErr)
+				}
+
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+type benchmarkGetStructTagsStruct struct {
+	Attribute01 string `tfsdk:"attribute_01"`
+	Attribute02 string `tfsdk:"attribute_02"`
+	Attribute03 string `tfsdk:"attribute_03"`
+	Attribute04 string `tfsdk:"attribute_04"`
+	Attribute05 string `tfsdk:"attribute_05"`
+	Attribute06 string `tfsdk:"attribute_06"`
+	Attribute07 string `tfsdk:"attribute_07"`
+	Attribute08 string `tfsdk:"attribute_08"`
+	Attribute09 string `tfsdk:"attribute_09"`
+	Attribute10 string `tfsdk:"attribute_10"`
+}
+
+func BenchmarkGetStructTags(b *testing.B) {
+	ctx := context.Background()
+	in := reflect.ValueOf(benchmarkGetStructTagsStruct{})
+
+	b.Run("cached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, _ = getStructTags(ctx, in, path.Empty())
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			structTagsCache.Delete(in.Type())
+
+			_, _ = getStructTags(ctx, in, path.Empty())
+		}
+	})
+}
+
 func TestCanBeNil_struct(t *testing.T) {
 	t.Parallel()
 
@@ -174,3 +305,29 @@ func TestCanBeNil_interface(t *testing.T) {
 		t.Errorf("Expected interfaces to be nillable, but canBeNil said they weren't")
 	}
 }
+
+func TestSnakeCaseFieldName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":                 "",
+		"Name":             "name",
+		"ExampleAttribute": "example_attribute",
+		"ID":               "id",
+		"HTTPEndpoint":     "http_endpoint",
+		"EndpointURL":      "endpoint_url",
+		"Attribute2":       "attribute2",
+		"Attribute2Name":   "attribute2_name",
+		"already_snake":    "already_snake",
+	}
+	for input, expected := range tests {
+		input, expected := input, expected
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+			got := SnakeCaseFieldName(input)
+			if got != expected {
+				t.Errorf("Expected %q, got %q", expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Unknown is the sentinel value used when an unknown value is built into an
+// empty interface target.
+type Unknown struct{}
+
+// isEmptyInterface returns true if the target is an empty interface type, such
+// as interface{} or any.
+func isEmptyInterface(target reflect.Value) bool {
+	return target.Kind() == reflect.Interface && target.NumMethod() == 0
+}
+
+// Interface builds an empty interface value using the natural Go type of the
+// data in `val`:
+//
+//   - null values are nil
+//   - unknown values are Unknown{}
+//   - strings are string
+//   - bools are bool
+//   - numbers are int64 when they are an exact int64, otherwise float64
+//   - lists, sets, and tuples are []interface{}
+//   - maps and objects are map[string]interface{}
+//
+// The type of `val` is used rather than an attr.Type, so dynamic values are
+// built the same as any other value.
+//
+// It is meant to be called through `Into`, not directly.
+func Interface(ctx context.Context, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result, err := interfaceValue(val)
+
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+
+		return target, diags
+	}
+
+	if result == nil {
+		return reflect.Zero(target.Type()), diags
+	}
+
+	return reflect.ValueOf(result), diags
+}
+
+// interfaceValue returns the natural Go type of the data in val, recursing
+// into collection and structural types.
+func interfaceValue(val tftypes.Value) (interface{}, error) {
+	if !val.IsKnown() {
+		return Unknown{}, nil
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := val.As(&s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := val.As(&b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+
+		if err := val.As(&n); err != nil {
+			return nil, err
+		}
+
+		if n.IsInt() {
+			if i, accuracy := n.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+
+		f, _ := n.Float64()
+
+		return f, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, err
+		}
+
+		result := make([]interface{}, 0, len(elems))
+
+		for _, elem := range elems {
+			elemValue, err := interfaceValue(elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, elemValue)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]interface{}, len(elems))
+
+		for key, elem := range elems {
+			elemValue, err := interfaceValue(elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = elemValue
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
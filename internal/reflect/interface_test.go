@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package reflect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInto_Interface(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         tftypes.Value
+		expected      interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"bool": {
+			typ:      types.BoolType,
+			value:    tftypes.NewValue(tftypes.Bool, true),
+			expected: true,
+		},
+		"number-int64": {
+			typ:      types.Int64Type,
+			value:    tftypes.NewValue(tftypes.Number, 123),
+			expected: int64(123),
+		},
+		"number-float64": {
+			typ:      types.Float64Type,
+			value:    tftypes.NewValue(tftypes.Number, 1.5),
+			expected: float64(1.5),
+		},
+		"string": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, "hello"),
+			expected: "hello",
+		},
+		"null": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, nil),
+			expected: nil,
+		},
+		"unknown": {
+			typ:      types.StringType,
+			value:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: refl.Unknown{},
+		},
+		"list": {
+			typ: types.ListType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, nil),
+			}),
+			expected: []interface{}{"hello", refl.Unknown{}, nil},
+		},
+		"map": {
+			typ: types.MapType{ElemType: types.Int64Type},
+			value: tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.Number, 1),
+				"two": tftypes.NewValue(tftypes.Number, 2),
+			}),
+			expected: map[string]interface{}{"one": int64(1), "two": int64(2)},
+		},
+		"dynamic-object": {
+			typ: types.DynamicType,
+			value: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"bool":  tftypes.Bool,
+						"tuple": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number}},
+					},
+				},
+				map[string]tftypes.Value{
+					"bool": tftypes.NewValue(tftypes.Bool, false),
+					"tuple": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number}}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "hello"),
+						tftypes.NewValue(tftypes.Number, 2.5),
+					}),
+				},
+			),
+			expected: map[string]interface{}{
+				"bool":  false,
+				"tuple": []interface{}{"hello", float64(2.5)},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got interface{}
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.value, &got, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_InterfaceStructFields(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Count   interface{} `tfsdk:"count"`
+		Dynamic interface{} `tfsdk:"dynamic"`
+		Enabled interface{} `tfsdk:"enabled"`
+		Name    interface{} `tfsdk:"name"`
+		Tags    interface{} `tfsdk:"tags"`
+	}
+
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"count":   types.Int64Type,
+			"dynamic": types.DynamicType,
+			"enabled": types.BoolType,
+			"name":    types.StringType,
+			"tags":    types.SetType{ElemType: types.StringType},
+		},
+	}
+	value := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"count":   tftypes.Number,
+				"dynamic": tftypes.DynamicPseudoType,
+				"enabled": tftypes.Bool,
+				"name":    tftypes.String,
+				"tags":    tftypes.Set{ElementType: tftypes.String},
+			},
+		},
+		map[string]tftypes.Value{
+			"count":   tftypes.NewValue(tftypes.Number, 3),
+			"dynamic": tftypes.NewValue(tftypes.String, "dynamic"),
+			"enabled": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			"name":    tftypes.NewValue(tftypes.String, nil),
+			"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "tag"),
+			}),
+		},
+	)
+	expected := model{
+		Count:   int64(3),
+		Dynamic: "dynamic",
+		Enabled: refl.Unknown{},
+		Name:    nil,
+		Tags:    []interface{}{"tag"},
+	}
+
+	var got model
+
+	diags := refl.Into(context.Background(), typ, value, &got, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value difference: %s", diff)
+	}
+}
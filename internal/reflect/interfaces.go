@@ -16,6 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+// StructWithFieldNames is an interface for Go struct types which determine
+// the attribute name of struct fields without a tfsdk struct tag.
+type StructWithFieldNames interface {
+	TFSDKFieldName(fieldName string) string
+}
+
 // Unknownable is an interface for types that can be explicitly set to known or
 // unknown.
 type Unknownable interface {
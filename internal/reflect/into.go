@@ -106,6 +106,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 			return target, nil
 		}
 	}
+	// empty interfaces hold the natural Go type of any value, including
+	// unknown, null, and dynamic values, so handle them before any of those
+	if isEmptyInterface(target) {
+		return Interface(ctx, val, target, path)
+	}
 	if !val.IsKnown() {
 		// we already handled unknown the only ways we can
 		// we checked that target doesn't have a SetUnknown method we
@@ -162,6 +167,11 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		return target, diags
 	}
 
+	// time.Duration is technically an integer, but we want it handled as a
+	// duration string for string types
+	if isStringDuration(target.Type(), val.Type()) {
+		return Duration(ctx, typ, val, target, path)
+	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
@@ -133,6 +133,32 @@ func TestInto_Slices(t *testing.T) {
 				),
 			},
 		},
+		"list-null-to-go-slice": {
+			typ:      types.ListType{ElemType: types.StringType},
+			value:    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			target:   make([]string, 0),
+			expected: nil,
+		},
+		"list-unknown-element-to-go-slice": {
+			typ: types.ListType{ElemType: types.StringType},
+			value: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			target:   make([]string, 0),
+			expected: make([]string, 0),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: [1]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
 		"list-to-incompatible-type": {
 			typ:      types.ListType{ElemType: types.StringType},
 			value:    tftypes.NewValue(tftypes.String, "hello"),
@@ -231,3 +257,307 @@ func TestInto_Slices(t *testing.T) {
 		})
 	}
 }
+
+func TestInto_SliceOfStructPointers(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+	tfObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		value         tftypes.Value
+		expected      []*testStruct
+		expectedDiags diag.Diagnostics
+	}{
+		"list-null": {
+			typ:      types.ListType{ElemType: objectType},
+			value:    tftypes.NewValue(tftypes.List{ElementType: tfObjectType}, nil),
+			expected: nil,
+		},
+		"list-elements": {
+			typ: types.ListType{ElemType: objectType},
+			value: tftypes.NewValue(tftypes.List{ElementType: tfObjectType}, []tftypes.Value{
+				tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "first"),
+				}),
+				tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "second"),
+				}),
+			}),
+			expected: []*testStruct{
+				{Name: "first"},
+				{Name: "second"},
+			},
+		},
+		"list-null-element": {
+			typ: types.ListType{ElemType: objectType},
+			value: tftypes.NewValue(tftypes.List{ElementType: tfObjectType}, []tftypes.Value{
+				tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "first"),
+				}),
+				tftypes.NewValue(tfObjectType, nil),
+				tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "third"),
+				}),
+			}),
+			expected: []*testStruct{
+				{Name: "first"},
+				nil,
+				{Name: "third"},
+			},
+		},
+		"set-null-element": {
+			typ: types.SetType{ElemType: objectType},
+			value: tftypes.NewValue(tftypes.Set{ElementType: tfObjectType}, []tftypes.Value{
+				tftypes.NewValue(tfObjectType, nil),
+			}),
+			expected: []*testStruct{
+				nil,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target []*testStruct
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.value, &target, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_MapOfStructs(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name    string      `tfsdk:"name"`
+		Enabled types.Bool  `tfsdk:"enabled"`
+		Count   types.Int64 `tfsdk:"count"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"count":   types.Int64Type,
+			"enabled": types.BoolType,
+			"name":    types.StringType,
+		},
+	}
+	tfObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count":   tftypes.Number,
+			"enabled": tftypes.Bool,
+			"name":    tftypes.String,
+		},
+	}
+	typ := types.MapType{ElemType: objectType}
+	tfType := tftypes.Map{ElementType: tfObjectType}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		expected      map[string]testStruct
+		expectedValue attr.Value
+	}{
+		"null": {
+			value:         tftypes.NewValue(tfType, nil),
+			expected:      nil,
+			expectedValue: types.MapNull(objectType),
+		},
+		"empty": {
+			value:         tftypes.NewValue(tfType, map[string]tftypes.Value{}),
+			expected:      map[string]testStruct{},
+			expectedValue: types.MapValueMust(objectType, map[string]attr.Value{}),
+		},
+		"elements": {
+			value: tftypes.NewValue(tfType, map[string]tftypes.Value{
+				"second": tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, nil),
+					"enabled": tftypes.NewValue(tftypes.Bool, false),
+					"name":    tftypes.NewValue(tftypes.String, "second-name"),
+				}),
+				"first": tftypes.NewValue(tfObjectType, map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, 1),
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"name":    tftypes.NewValue(tftypes.String, "first-name"),
+				}),
+			}),
+			expected: map[string]testStruct{
+				"first": {
+					Name:    "first-name",
+					Enabled: types.BoolValue(true),
+					Count:   types.Int64Value(1),
+				},
+				"second": {
+					Name:    "second-name",
+					Enabled: types.BoolValue(false),
+					Count:   types.Int64Null(),
+				},
+			},
+			expectedValue: types.MapValueMust(objectType, map[string]attr.Value{
+				"first": types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+					"count":   types.Int64Value(1),
+					"enabled": types.BoolValue(true),
+					"name":    types.StringValue("first-name"),
+				}),
+				"second": types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+					"count":   types.Int64Null(),
+					"enabled": types.BoolValue(false),
+					"name":    types.StringValue("second-name"),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target map[string]testStruct
+
+			diags := refl.Into(context.Background(), typ, testCase.value, &target, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Into diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Null maps must decode to nil and empty maps to non-nil, so the
+			// value is preserved when converted back.
+			if (target == nil) != (testCase.expected == nil) {
+				t.Errorf("expected nil map %t, got %t", testCase.expected == nil, target == nil)
+			}
+
+			got, diags := refl.FromValue(context.Background(), typ, target, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected FromValue diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedValue); diff != "" {
+				t.Errorf("unexpected FromValue difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInto_NestedObjectStructPointer(t *testing.T) {
+	t.Parallel()
+
+	type nestedStruct struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	type testStruct struct {
+		Nested *nestedStruct `tfsdk:"nested"`
+	}
+
+	nestedType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested": nestedType,
+		},
+	}
+	tfNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	tfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested": tfNestedType,
+		},
+	}
+
+	testCases := map[string]struct {
+		value    tftypes.Value
+		expected testStruct
+	}{
+		"nested-null": {
+			value: tftypes.NewValue(tfType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tfNestedType, nil),
+			}),
+			expected: testStruct{},
+		},
+		"nested-value": {
+			value: tftypes.NewValue(tfType, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tfNestedType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+			expected: testStruct{
+				Nested: &nestedStruct{
+					Name: types.StringNull(),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target testStruct
+
+			diags := refl.Into(context.Background(), typ, testCase.value, &target, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Into diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			got, diags := refl.FromValue(context.Background(), typ, target, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected FromValue diagnostics: %v", diags)
+			}
+
+			gotValue, err := got.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected ToTerraformValue error: %s", err)
+			}
+
+			if !gotValue.Equal(testCase.value) {
+				t.Errorf("expected round trip value %s, got %s", testCase.value, gotValue)
+			}
+		})
+	}
+}
@@ -52,6 +52,105 @@ func TestReflectMap_string(t *testing.T) {
 	}
 }
 
+func TestInto_Maps(t *testing.T) {
+	t.Parallel()
+
+	tfMapType := tftypes.Map{ElementType: tftypes.String}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		expected      map[string]string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    tftypes.NewValue(tfMapType, nil),
+			expected: nil,
+		},
+		"empty": {
+			value:    tftypes.NewValue(tfMapType, map[string]tftypes.Value{}),
+			expected: map[string]string{},
+		},
+		"elements": {
+			value: tftypes.NewValue(tfMapType, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, "value1"),
+				"key2": tftypes.NewValue(tftypes.String, "value2"),
+			}),
+			expected: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		"unknown-element": {
+			value: tftypes.NewValue(tfMapType, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("key1"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: [\"key1\"]\nTarget Type: string\nSuggested Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target map[string]string
+
+			diags := refl.Into(context.Background(), types.MapType{ElemType: types.StringType}, testCase.value, &target, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics: %s", diff)
+			}
+
+			if diff := cmp.Diff(target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Null and empty maps must remain distinct.
+			if (target == nil) != (testCase.expected == nil) {
+				t.Errorf("expected nil map %t, got %t", testCase.expected == nil, target == nil)
+			}
+		})
+	}
+}
+
+func TestInto_MapOfStringValues(t *testing.T) {
+	t.Parallel()
+
+	var target map[string]types.String
+
+	value := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"known":   tftypes.NewValue(tftypes.String, "value"),
+		"null":    tftypes.NewValue(tftypes.String, nil),
+		"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	expected := map[string]types.String{
+		"known":   types.StringValue("value"),
+		"null":    types.StringNull(),
+		"unknown": types.StringUnknown(),
+	}
+
+	diags := refl.Into(context.Background(), types.MapType{ElemType: types.StringType}, value, &target, refl.Options{}, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(target, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestFromMap(t *testing.T) {
 	t.Parallel()
 
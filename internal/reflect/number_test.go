@@ -1096,3 +1096,111 @@ func TestFromBigInt(t *testing.T) {
 		})
 	}
 }
+
+func TestNumber_int64ZeroAndNull(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Value   int64  `tfsdk:"value"`
+		Pointer *int64 `tfsdk:"pointer"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"value":   types.Int64Type,
+			"pointer": types.Int64Type,
+		},
+	}
+
+	zero := int64(0)
+
+	testCases := map[string]struct {
+		goValue   testStruct
+		attrValue attr.Value
+	}{
+		"zero-value-nil-pointer": {
+			goValue: testStruct{
+				Value:   0,
+				Pointer: nil,
+			},
+			attrValue: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"value":   types.Int64Value(0),
+					"pointer": types.Int64Null(),
+				},
+			),
+		},
+		"zero-value-zero-pointer": {
+			goValue: testStruct{
+				Value:   0,
+				Pointer: &zero,
+			},
+			attrValue: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"value":   types.Int64Value(0),
+					"pointer": types.Int64Value(0),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotValue, diags := refl.FromValue(context.Background(), objectType, testCase.goValue, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(gotValue, testCase.attrValue); diff != "" {
+				t.Errorf("unexpected FromValue difference: %s", diff)
+			}
+
+			tfValue, err := testCase.attrValue.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotStruct testStruct
+
+			diags = refl.Into(context.Background(), objectType, tfValue, &gotStruct, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(gotStruct, testCase.goValue); diff != "" {
+				t.Errorf("unexpected Into difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumber_int64NullError(t *testing.T) {
+	t.Parallel()
+
+	var n int64
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+				"Path: test\nTarget Type: int64\nSuggested `types` Type: basetypes.Int64Value\nSuggested Pointer Type: *int64",
+		),
+	}
+
+	diags := refl.Into(context.Background(), types.Int64Type, tftypes.NewValue(tftypes.Number, nil), &n, refl.Options{}, path.Root("test"))
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
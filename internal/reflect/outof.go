@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,6 +20,9 @@ import (
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
 // method.
+//
+// Non-pointer Go values, including numeric zero values, always produce known
+// values. Nil pointers produce null values.
 func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -34,14 +38,26 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	if v, ok := val.(Nullable); ok {
 		return FromNullable(ctx, typ, v, path)
 	}
-	if bf, ok := val.(*big.Float); ok {
+	if bf, ok := val.(*big.Float); ok && bf != nil {
+		if !canHoldPrimitive(ctx, typ, tftypes.Number) {
+			return nil, append(diags, fromTypeMismatchErrorDiag(typ, val, path))
+		}
 		return FromBigFloat(ctx, typ, bf, path)
 	}
-	if bi, ok := val.(*big.Int); ok {
+	if bi, ok := val.(*big.Int); ok && bi != nil {
+		if !canHoldPrimitive(ctx, typ, tftypes.Number) {
+			return nil, append(diags, fromTypeMismatchErrorDiag(typ, val, path))
+		}
 		return FromBigInt(ctx, typ, bi, path)
 	}
+	if d, ok := val.(time.Duration); ok && isStringDuration(durationType, typ.TerraformType(ctx)) {
+		return FromDuration(ctx, typ, d, path)
+	}
 	value := reflect.ValueOf(val)
 	kind := value.Kind()
+	if primitiveType := primitiveTerraformType(kind); primitiveType != nil && !canHoldPrimitive(ctx, typ, primitiveType) {
+		return nil, append(diags, fromTypeMismatchErrorDiag(typ, val, path))
+	}
 	switch kind {
 	case reflect.Struct:
 		t, ok := typ.(attr.TypeWithAttributeTypes)
@@ -93,3 +109,28 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		return nil, diags
 	}
 }
+
+// primitiveTerraformType returns the Terraform type of Go primitive kinds, or
+// nil for other kinds.
+func primitiveTerraformType(kind reflect.Kind) tftypes.Type {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return tftypes.Number
+	case reflect.Bool:
+		return tftypes.Bool
+	case reflect.String:
+		return tftypes.String
+	default:
+		return nil
+	}
+}
+
+// canHoldPrimitive returns true if `typ` can hold a Go primitive value of
+// the given Terraform type. Dynamic types can hold any value.
+func canHoldPrimitive(ctx context.Context, typ attr.Type, primitiveType tftypes.Type) bool {
+	tfType := typ.TerraformType(ctx)
+
+	return tfType.Is(primitiveType) || tfType.Is(tftypes.DynamicPseudoType)
+}
@@ -404,3 +404,48 @@ func TestFromSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestFromSlice_structPointers(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	got, diags := refl.FromValue(
+		context.Background(),
+		types.ListType{ElemType: objectType},
+		[]*testStruct{
+			{Name: "first"},
+			nil,
+		},
+		path.Empty(),
+	)
+
+	expected := types.ListValueMust(
+		objectType,
+		[]attr.Value{
+			types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"name": types.StringValue("first"),
+				},
+			),
+			types.ObjectNull(objectType.AttrTypes),
+		},
+	)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+		t.Errorf("unexpected diagnostics: %s", diff)
+	}
+}
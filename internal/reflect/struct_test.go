@@ -226,6 +226,38 @@ func TestNewStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestNewStruct_customTypes(t *testing.T) {
+	t.Parallel()
+
+	var s struct {
+		A testtypes.String `tfsdk:"a"`
+	}
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": testtypes.StringType{},
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+
+	expected := testtypes.String{
+		InternalString: types.StringValue("hello"),
+		CreatedBy:      testtypes.StringType{},
+	}
+
+	if diff := cmp.Diff(expected, s.A); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestNewStruct_complex(t *testing.T) {
 	t.Parallel()
 
@@ -608,6 +640,45 @@ func TestFromStruct_primitives(t *testing.T) {
 	}
 }
 
+func TestFromStruct_customTypes(t *testing.T) {
+	t.Parallel()
+
+	type disk struct {
+		Name testtypes.String `tfsdk:"name"`
+	}
+	disk1 := disk{
+		Name: testtypes.String{
+			InternalString: types.StringValue("myfirstdisk"),
+			CreatedBy:      testtypes.StringType{},
+		},
+	}
+
+	actualVal, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": testtypes.StringType{},
+		},
+	}, reflect.ValueOf(disk1), path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expectedVal := types.ObjectValueMust(
+		map[string]attr.Type{
+			"name": testtypes.StringType{},
+		},
+		map[string]attr.Value{
+			"name": testtypes.String{
+				InternalString: types.StringValue("myfirstdisk"),
+				CreatedBy:      testtypes.StringType{},
+			},
+		},
+	)
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestFromStruct_complex(t *testing.T) {
 	t.Parallel()
 
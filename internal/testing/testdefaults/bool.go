@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Bool = Bool{}
+var _ defaults.DependsOn = Bool{}
 
 // Declarative defaults.Bool for unit testing.
 type Bool struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Bool struct {
 	DefaultBoolMethod func(context.Context, defaults.BoolRequest, *defaults.BoolResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Bool) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Bool) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
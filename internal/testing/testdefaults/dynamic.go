@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Dynamic = Dynamic{}
+var _ defaults.DependsOn = Dynamic{}
 
 // Declarative defaults.Dynamic for unit testing.
 type Dynamic struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Dynamic struct {
 	DefaultDynamicMethod func(context.Context, defaults.DynamicRequest, *defaults.DynamicResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Dynamic) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Dynamic) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
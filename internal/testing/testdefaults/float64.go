@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Float64 = Float64{}
+var _ defaults.DependsOn = Float64{}
 
 // Declarative defaults.Float64 for unit testing.
 type Float64 struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Float64 struct {
 	DefaultFloat64Method func(context.Context, defaults.Float64Request, *defaults.Float64Response)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Float64) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Float64) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
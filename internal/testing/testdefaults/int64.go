@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Int64 = Int64{}
+var _ defaults.DependsOn = Int64{}
 
 // Declarative defaults.Int64 for unit testing.
 type Int64 struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Int64 struct {
 	DefaultInt64Method func(context.Context, defaults.Int64Request, *defaults.Int64Response)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Int64) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Int64) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.List = List{}
+var _ defaults.DependsOn = List{}
 
 // Declarative defaults.List for unit testing.
 type List struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type List struct {
 	DefaultListMethod func(context.Context, defaults.ListRequest, *defaults.ListResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v List) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v List) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Map = Map{}
+var _ defaults.DependsOn = Map{}
 
 // Declarative defaults.Map for unit testing.
 type Map struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Map struct {
 	DefaultMapMethod func(context.Context, defaults.MapRequest, *defaults.MapResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Map) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Map) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Number = Number{}
+var _ defaults.DependsOn = Number{}
 
 // Declarative defaults.Number for unit testing.
 type Number struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Number struct {
 	DefaultNumberMethod func(context.Context, defaults.NumberRequest, *defaults.NumberResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Number) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Number) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Object = Object{}
+var _ defaults.DependsOn = Object{}
 
 // Declarative defaults.Object for unit testing.
 type Object struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Object struct {
 	DefaultObjectMethod func(context.Context, defaults.ObjectRequest, *defaults.ObjectResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Object) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Object) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
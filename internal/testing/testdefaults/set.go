@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.Set = Set{}
+var _ defaults.DependsOn = Set{}
 
 // Declarative defaults.Set for unit testing.
 type Set struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type Set struct {
 	DefaultSetMethod func(context.Context, defaults.SetRequest, *defaults.SetResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v Set) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v Set) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -6,13 +6,18 @@ package testdefaults
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 )
 
 var _ defaults.String = String{}
+var _ defaults.DependsOn = String{}
 
 // Declarative defaults.String for unit testing.
 type String struct {
+	// defaults.DependsOn interface methods
+	DependsOnMethod func(context.Context) path.Expressions
+
 	// defaults.Describer interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
@@ -21,6 +26,15 @@ type String struct {
 	DefaultStringMethod func(context.Context, defaults.StringRequest, *defaults.StringResponse)
 }
 
+// DependsOn satisfies the defaults.DependsOn interface.
+func (v String) DependsOn(ctx context.Context) path.Expressions {
+	if v.DependsOnMethod == nil {
+		return nil
+	}
+
+	return v.DependsOnMethod(ctx)
+}
+
 // Description satisfies the defaults.Describer interface.
 func (v String) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
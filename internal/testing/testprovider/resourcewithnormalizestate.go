@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithNormalizeState{}
+var _ resource.ResourceWithNormalizeState = &ResourceWithNormalizeState{}
+
+// Declarative resource.ResourceWithNormalizeState for unit testing.
+type ResourceWithNormalizeState struct {
+	*Resource
+
+	// ResourceWithNormalizeState interface methods
+	NormalizeStateMethod func(context.Context, resource.NormalizeStateRequest, *resource.NormalizeStateResponse)
+}
+
+// NormalizeState satisfies the resource.ResourceWithNormalizeState interface.
+func (r *ResourceWithNormalizeState) NormalizeState(ctx context.Context, req resource.NormalizeStateRequest, resp *resource.NormalizeStateResponse) {
+	if r.NormalizeStateMethod == nil {
+		return
+	}
+
+	r.NormalizeStateMethod(ctx, req, resp)
+}
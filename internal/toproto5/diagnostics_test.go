@@ -113,6 +113,26 @@ func TestDiagnostics(t *testing.T) {
 				},
 			},
 		},
+		"DiagnosticWithPath-nested": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(1).AtName("nested"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test").AtMapKey("key").AtName("nested"), "two summary", "two detail"),
+			},
+			expected: []*tfprotov5.Diagnostic{
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1).WithAttributeName("nested"),
+					Detail:    "one detail",
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "one summary",
+				},
+				{
+					Attribute: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key").WithAttributeName("nested"),
+					Detail:    "two detail",
+					Severity:  tfprotov5.DiagnosticSeverityWarning,
+					Summary:   "two summary",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -920,6 +920,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"data-source-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.ListNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: datasourceschema.NestedBlockObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"test_attribute": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"data-source-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -964,6 +1012,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"data-source-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.SetNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: datasourceschema.NestedBlockObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"test_attribute": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"data-source-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -1888,6 +1984,51 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"provider-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{
+					Blocks: map[string]providerschema.Block{
+						"test_block": providerschema.ListNestedBlock{
+							MaxItems: 2,
+							MinItems: 1,
+							NestedObject: providerschema.NestedBlockObject{
+								Attributes: map[string]providerschema.Attribute{
+									"test_attribute": providerschema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{
+						BlockTypes: []*tfprotov5.SchemaNestedBlock{
+							{
+								Block: &tfprotov5.SchemaBlock{
+									Attributes: []*tfprotov5.SchemaAttribute{
+										{
+											Name:     "test_attribute",
+											Type:     tftypes.String,
+											Required: true,
+										},
+									},
+								},
+								MaxItems: 2,
+								MinItems: 1,
+								Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+								TypeName: "test_block",
+							},
+						},
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"provider-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{
@@ -1929,6 +2070,51 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"provider-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{
+					Blocks: map[string]providerschema.Block{
+						"test_block": providerschema.SetNestedBlock{
+							MaxItems: 2,
+							MinItems: 1,
+							NestedObject: providerschema.NestedBlockObject{
+								Attributes: map[string]providerschema.Attribute{
+									"test_attribute": providerschema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{
+						BlockTypes: []*tfprotov5.SchemaNestedBlock{
+							{
+								Block: &tfprotov5.SchemaBlock{
+									Attributes: []*tfprotov5.SchemaAttribute{
+										{
+											Name:     "test_attribute",
+											Type:     tftypes.String,
+											Required: true,
+										},
+									},
+								},
+								MaxItems: 2,
+								MinItems: 1,
+								Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+								TypeName: "test_block",
+							},
+						},
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"provider-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{
@@ -3427,6 +3613,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Blocks: map[string]resourceschema.Block{
+							"test_block": resourceschema.ListNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: resourceschema.NestedBlockObject{
+									Attributes: map[string]resourceschema.Attribute{
+										"test_attribute": resourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -3471,6 +3705,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Blocks: map[string]resourceschema.Block{
+							"test_block": resourceschema.SetNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: resourceschema.NestedBlockObject{
+									Attributes: map[string]resourceschema.Attribute{
+										"test_attribute": resourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -45,6 +45,11 @@ func Block(ctx context.Context, name string, path *tftypes.AttributePath, b fwsc
 		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
 	}
 
+	if blockWithItemBounds, ok := b.(fwschema.BlockWithItemBounds); ok {
+		schemaNestedBlock.MaxItems = blockWithItemBounds.GetMaxItems()
+		schemaNestedBlock.MinItems = blockWithItemBounds.GetMinItems()
+	}
+
 	nestedBlockObject := b.GetNestedObject()
 
 	for attrName, attr := range nestedBlockObject.GetAttributes() {
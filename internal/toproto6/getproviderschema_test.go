@@ -968,6 +968,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
+		"data-source-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.ListNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: datasourceschema.NestedBlockObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"test_attribute": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
 		"data-source-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -1012,6 +1060,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
+		"data-source-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Blocks: map[string]datasourceschema.Block{
+							"test_block": datasourceschema.SetNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: datasourceschema.NestedBlockObject{
+									Attributes: map[string]datasourceschema.Attribute{
+										"test_attribute": datasourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
 		"data-source-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -1988,6 +2084,51 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
+		"provider-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{
+					Blocks: map[string]providerschema.Block{
+						"test_block": providerschema.ListNestedBlock{
+							MaxItems: 2,
+							MinItems: 1,
+							NestedObject: providerschema.NestedBlockObject{
+								Attributes: map[string]providerschema.Attribute{
+									"test_attribute": providerschema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:         map[string]*tfprotov6.Function{},
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{
+						BlockTypes: []*tfprotov6.SchemaNestedBlock{
+							{
+								Block: &tfprotov6.SchemaBlock{
+									Attributes: []*tfprotov6.SchemaAttribute{
+										{
+											Name:     "test_attribute",
+											Type:     tftypes.String,
+											Required: true,
+										},
+									},
+								},
+								MaxItems: 2,
+								MinItems: 1,
+								Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+								TypeName: "test_block",
+							},
+						},
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
 		"provider-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{
@@ -2029,6 +2170,51 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
 		},
+		"provider-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{
+					Blocks: map[string]providerschema.Block{
+						"test_block": providerschema.SetNestedBlock{
+							MaxItems: 2,
+							MinItems: 1,
+							NestedObject: providerschema.NestedBlockObject{
+								Attributes: map[string]providerschema.Attribute{
+									"test_attribute": providerschema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:         map[string]*tfprotov6.Function{},
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{
+						BlockTypes: []*tfprotov6.SchemaNestedBlock{
+							{
+								Block: &tfprotov6.SchemaBlock{
+									Attributes: []*tfprotov6.SchemaAttribute{
+										{
+											Name:     "test_attribute",
+											Type:     tftypes.String,
+											Required: true,
+										},
+									},
+								},
+								MaxItems: 2,
+								MinItems: 1,
+								Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+								TypeName: "test_block",
+							},
+						},
+					},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{},
+			},
+		},
 		"provider-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				Provider: providerschema.Schema{
@@ -3627,6 +3813,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-block-list-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Blocks: map[string]resourceschema.Block{
+							"test_block": resourceschema.ListNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: resourceschema.NestedBlockObject{
+									Attributes: map[string]resourceschema.Attribute{
+										"test_attribute": resourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:         map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-block-set": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -3671,6 +3905,54 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-block-set-items": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Blocks: map[string]resourceschema.Block{
+							"test_block": resourceschema.SetNestedBlock{
+								MaxItems: 2,
+								MinItems: 1,
+								NestedObject: resourceschema.NestedBlockObject{
+									Attributes: map[string]resourceschema.Attribute{
+										"test_attribute": resourceschema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:         map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							BlockTypes: []*tfprotov6.SchemaNestedBlock{
+								{
+									Block: &tfprotov6.SchemaBlock{
+										Attributes: []*tfprotov6.SchemaAttribute{
+											{
+												Name:     "test_attribute",
+												Type:     tftypes.String,
+												Required: true,
+											},
+										},
+									},
+									MaxItems: 2,
+									MinItems: 1,
+									Nesting:  tfprotov6.SchemaNestedBlockNestingModeSet,
+									TypeName: "test_block",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-block-single": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package useragent contains framework internal helpers for assembling the
+// provider User-Agent and passing it to provider code via context.
+package useragent
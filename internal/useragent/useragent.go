@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package useragent
+
+import (
+	"context"
+	"strings"
+)
+
+// contextKey is the unexported type for context keys in this package, which
+// prevents collisions with other packages.
+type contextKey struct{}
+
+// New returns a User-Agent string containing the Terraform and provider
+// information, such as:
+//
+//	Terraform/1.5.0 (+https://www.terraform.io) terraform-provider-examplecloud/1.2.3
+//
+// Empty information is omitted from the result.
+func New(terraformVersion string, providerTypeName string, providerVersion string) string {
+	var products []string
+
+	if terraformVersion != "" {
+		products = append(products, "Terraform/"+terraformVersion+" (+https://www.terraform.io)")
+	}
+
+	if providerTypeName != "" {
+		product := "terraform-provider-" + providerTypeName
+
+		if providerVersion != "" {
+			product += "/" + providerVersion
+		}
+
+		products = append(products, product)
+	}
+
+	return strings.Join(products, " ")
+}
+
+// NewContext returns a context containing the User-Agent.
+func NewContext(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, contextKey{}, userAgent)
+}
+
+// FromContext returns the User-Agent from the context, if any.
+func FromContext(ctx context.Context) string {
+	userAgent, _ := ctx.Value(contextKey{}).(string)
+
+	return userAgent
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package useragent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/useragent"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		terraformVersion string
+		providerTypeName string
+		providerVersion  string
+		expected         string
+	}{
+		"empty": {
+			expected: "",
+		},
+		"all": {
+			terraformVersion: "1.5.0",
+			providerTypeName: "examplecloud",
+			providerVersion:  "1.2.3",
+			expected:         "Terraform/1.5.0 (+https://www.terraform.io) terraform-provider-examplecloud/1.2.3",
+		},
+		"missing-terraform-version": {
+			providerTypeName: "examplecloud",
+			providerVersion:  "1.2.3",
+			expected:         "terraform-provider-examplecloud/1.2.3",
+		},
+		"missing-provider-type-name": {
+			terraformVersion: "1.5.0",
+			providerVersion:  "1.2.3",
+			expected:         "Terraform/1.5.0 (+https://www.terraform.io)",
+		},
+		"missing-provider-version": {
+			terraformVersion: "1.5.0",
+			providerTypeName: "examplecloud",
+			expected:         "Terraform/1.5.0 (+https://www.terraform.io) terraform-provider-examplecloud",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := useragent.New(testCase.terraformVersion, testCase.providerTypeName, testCase.providerVersion)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"empty": {
+			ctx:      context.Background(),
+			expected: "",
+		},
+		"user-agent": {
+			ctx:      useragent.NewContext(context.Background(), "terraform-provider-examplecloud/1.2.3"),
+			expected: "terraform-provider-examplecloud/1.2.3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := useragent.FromContext(testCase.ctx)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -50,6 +50,19 @@ func (s Schema) AttributeAtTerraformPath(ctx context.Context, p *tftypes.Attribu
 	return fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
 }
 
+// Equal returns true if the given Schema is a provider meta Schema and is
+// equivalent, including attributes, blocks, types, nesting, and
+// configurability options. Nil and empty attribute or block maps are
+// considered equal. This method signature also enables go-cmp to use it
+// when comparing schemas, such as in provider unit tests with cmp.Diff.
+func (s Schema) Equal(o fwschema.Schema) bool {
+	if _, ok := o.(Schema); !ok {
+		return false
+	}
+
+	return fwschema.SchemasEqual(s, o)
+}
+
 // GetAttributes returns the Attributes field value.
 func (s Schema) GetAttributes() map[string]fwschema.Attribute {
 	return schemaAttributes(s.Attributes)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -357,6 +358,115 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   metaschema.Schema
+		other    fwschema.Schema
+		expected bool
+	}{
+		"empty": {
+			schema:   metaschema.Schema{},
+			other:    metaschema.Schema{},
+			expected: true,
+		},
+		"different-type": {
+			schema:   metaschema.Schema{},
+			other:    testschema.Schema{},
+			expected: false,
+		},
+		"nil-and-empty-attributes": {
+			schema: metaschema.Schema{},
+			other: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{},
+			},
+			expected: true,
+		},
+		"attributes-equal": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: true,
+		},
+		"attributes-different-name": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr1": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr2": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-length": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other:    metaschema.Schema{},
+			expected: false,
+		},
+		"attributes-different-attribute-type": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"testattr": metaschema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			// The Equal method should also be used by go-cmp.
+			if testCase.expected && !cmp.Equal(testCase.schema, testCase.other) {
+				t.Errorf("expected cmp.Equal to return true, got false")
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -824,7 +934,9 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -864,10 +976,47 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"empty-schema": {
 			schema: metaschema.Schema{},
 		},
+		"attribute-missing-required-optional-computed": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": metaschema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
+		"attribute-required-and-optional": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"test": metaschema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is both Required and Optional. "+
+						"Set only one of these fields, depending on whether the attribute must be configured.",
+				),
+			},
+		},
 		"attribute-using-invalid-field-name": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
-					"^": metaschema.StringAttribute{},
+					"^": metaschema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -880,13 +1029,37 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"nested-attribute-missing-required-optional-computed": {
+			schema: metaschema.Schema{
+				Attributes: map[string]metaschema.Attribute{
+					"single_nested_attribute": metaschema.SingleNestedAttribute{
+						Attributes: map[string]metaschema.Attribute{
+							"test": metaschema.StringAttribute{},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-invalid-field-name": {
 			schema: metaschema.Schema{
 				Attributes: map[string]metaschema.Attribute{
 					"single_nested_attribute": metaschema.SingleNestedAttribute{
 						Attributes: map[string]metaschema.Attribute{
-							"^": metaschema.BoolAttribute{},
+							"^": metaschema.BoolAttribute{
+								Optional: true,
+							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -929,6 +1102,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 								},
 							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Configure calls the Schema and Configure methods of the provider, similar
+// to the ConfigureProvider RPC, and returns the response of the Configure
+// method. The provider configuration is built from the provider schema and
+// the given values, keyed by top level attribute or block name. Attributes
+// and blocks without a value are null, as if they were not configured.
+//
+// The response Diagnostics contains any errors from the Schema method or
+// from building the configuration, in which case the Configure method is not
+// called.
+func Configure(ctx context.Context, p provider.Provider, values map[string]attr.Value) provider.ConfigureResponse {
+	var resp provider.ConfigureResponse
+
+	schemaResp := provider.SchemaResponse{}
+
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	resp.Diagnostics.Append(schemaResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return resp
+	}
+
+	config, diags := newConfig(ctx, schemaResp.Schema, values)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return resp
+	}
+
+	req := provider.ConfigureRequest{
+		Config: config,
+	}
+
+	p.Configure(ctx, req, &resp)
+
+	return resp
+}
+
+// newConfig returns the tfsdk.Config of the schema with the given top level
+// attribute and block values.
+func newConfig(ctx context.Context, s schema.Schema, values map[string]attr.Value) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		diags.AddError(
+			"Invalid Provider Schema",
+			fmt.Sprintf("Expected the provider schema type to be an object, got: %T", s.Type().TerraformType(ctx)),
+		)
+
+		return tfsdk.Config{}, diags
+	}
+
+	names := make([]string, 0, len(values))
+
+	for name := range values {
+		names = append(names, name)
+	}
+
+	// Ensure diagnostics are returned in a consistent order.
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := schemaType.AttributeTypes[name]; !ok {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Provider Configuration Value",
+				fmt.Sprintf("The provider schema does not contain an attribute or block named %q.", name),
+			)
+		}
+	}
+
+	objectValues := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+
+	for name, attributeType := range schemaType.AttributeTypes {
+		value, ok := values[name]
+
+		if !ok || value == nil {
+			objectValues[name] = tftypes.NewValue(attributeType, nil)
+
+			continue
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Provider Configuration Value",
+				fmt.Sprintf("The value could not be converted to a Terraform value: %s", err),
+			)
+
+			continue
+		}
+
+		if !tfValue.Type().UsableAs(attributeType) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Provider Configuration Value",
+				fmt.Sprintf("Expected a value of type %s, got: %s", attributeType, tfValue.Type()),
+			)
+
+			continue
+		}
+
+		objectValues[name] = tfValue
+	}
+
+	if diags.HasError() {
+		return tfsdk.Config{}, diags
+	}
+
+	config := tfsdk.Config{
+		Raw:    tftypes.NewValue(schemaType, objectValues),
+		Schema: s,
+	}
+
+	return config, diags
+}
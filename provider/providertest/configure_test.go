@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providertest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/providertest"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testClient is an example API client which a provider creates during
+// Configure.
+type testClient struct {
+	Endpoint string
+	Retries  int64
+}
+
+type testProviderModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Retries  types.Int64  `tfsdk:"retries"`
+}
+
+func TestConfigure(t *testing.T) {
+	t.Parallel()
+
+	testSchemaMethod := func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+		resp.Schema = schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"endpoint": schema.StringAttribute{
+					Required: true,
+				},
+				"retries": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		}
+	}
+	testConfigureMethod := func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+		var data testProviderModel
+
+		resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		client := &testClient{
+			Endpoint: data.Endpoint.ValueString(),
+			Retries:  3,
+		}
+
+		if !data.Retries.IsNull() {
+			client.Retries = data.Retries.ValueInt64()
+		}
+
+		resp.DataSourceData = client
+		resp.ResourceData = client
+	}
+
+	testCases := map[string]struct {
+		provider provider.Provider
+		values   map[string]attr.Value
+		expected provider.ConfigureResponse
+	}{
+		"client": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: testConfigureMethod,
+				SchemaMethod:    testSchemaMethod,
+			},
+			values: map[string]attr.Value{
+				"endpoint": types.StringValue("https://example.com"),
+				"retries":  types.Int64Value(5),
+			},
+			expected: provider.ConfigureResponse{
+				DataSourceData: &testClient{
+					Endpoint: "https://example.com",
+					Retries:  5,
+				},
+				ResourceData: &testClient{
+					Endpoint: "https://example.com",
+					Retries:  5,
+				},
+			},
+		},
+		"client-unconfigured-attribute": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: testConfigureMethod,
+				SchemaMethod:    testSchemaMethod,
+			},
+			values: map[string]attr.Value{
+				"endpoint": types.StringValue("https://example.com"),
+			},
+			expected: provider.ConfigureResponse{
+				DataSourceData: &testClient{
+					Endpoint: "https://example.com",
+					Retries:  3,
+				},
+				ResourceData: &testClient{
+					Endpoint: "https://example.com",
+					Retries:  3,
+				},
+			},
+		},
+		"configure-diagnostics": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					resp.Diagnostics.AddError("test summary", "test detail")
+				},
+				SchemaMethod: testSchemaMethod,
+			},
+			expected: provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+		},
+		"schema-diagnostics": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					resp.Diagnostics.AddError("Unexpected Method Call", "Expected no Configure call")
+				},
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Diagnostics.AddError("test summary", "test detail")
+				},
+			},
+			expected: provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+			},
+		},
+		"value-type-mismatch": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: testConfigureMethod,
+				SchemaMethod:    testSchemaMethod,
+			},
+			values: map[string]attr.Value{
+				"endpoint": types.BoolValue(true),
+			},
+			expected: provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("endpoint"),
+						"Invalid Provider Configuration Value",
+						"Expected a value of type tftypes.String, got: tftypes.Bool",
+					),
+				},
+			},
+		},
+		"value-undefined": {
+			provider: &testprovider.Provider{
+				ConfigureMethod: testConfigureMethod,
+				SchemaMethod:    testSchemaMethod,
+			},
+			values: map[string]attr.Value{
+				"endpoint":  types.StringValue("https://example.com"),
+				"undefined": types.StringValue("test"),
+			},
+			expected: provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("undefined"),
+						"Invalid Provider Configuration Value",
+						`The provider schema does not contain an attribute or block named "undefined".`,
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := providertest.Configure(context.Background(), testCase.provider, testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providertest contains helpers for unit testing provider
+// implementations without running a provider server or Terraform.
+package providertest
@@ -22,6 +22,7 @@ var (
 	_ NestedAttribute                              = ListNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListNestedAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListNestedAttribute{}
+	_ fwschema.NestedAttributeWithItemBounds       = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -130,6 +131,16 @@ type ListNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of elements which must be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements which can be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no maximum.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -182,6 +193,16 @@ func (a ListNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (a ListNestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (a ListNestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (a ListNestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
@@ -23,6 +23,7 @@ var (
 	_ NestedAttribute                              = SetNestedAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SetNestedAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetNestedAttribute{}
+	_ fwschema.NestedAttributeWithItemBounds       = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -126,6 +127,16 @@ type SetNestedAttribute struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of elements which must be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no minimum.
+	MinItems int64
+
+	// MaxItems is the maximum number of elements which can be configured
+	// when the attribute value is known and not null. The framework enforces
+	// this during configuration validation. Zero means no maximum.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -178,6 +189,16 @@ func (a SetNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (a SetNestedAttribute) GetMaxItems() int64 {
+	return a.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (a SetNestedAttribute) GetMinItems() int64 {
+	return a.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (a SetNestedAttribute) GetNestedObject() fwschema.NestedAttributeObject {
 	return a.NestedObject
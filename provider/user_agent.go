@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/useragent"
+)
+
+// UserAgent returns the default User-Agent for provider HTTP clients, which
+// is available in the context of the Provider Configure method. It contains
+// the Terraform version, if sent by Terraform, and the provider type name and
+// version, if set in the Provider Metadata method. For example:
+//
+//	Terraform/1.5.0 (+https://www.terraform.io) terraform-provider-examplecloud/1.2.3
+//
+// Providers may append their own product information, such as the HTTP client
+// library and version. An empty string is returned outside of the Configure
+// method.
+func UserAgent(ctx context.Context) string {
+	return useragent.FromContext(ctx)
+}
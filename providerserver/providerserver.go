@@ -7,9 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
-	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
-	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
@@ -20,58 +17,48 @@ import (
 // NewProtocol5 returns a protocol version 5 ProviderServer implementation
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
-// function and various terraform-plugin-mux functions.
-func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
+// function and various terraform-plugin-mux functions. ServerOpt may be
+// given to configure the same server behaviors as the Serve function ServeOpts.
+func NewProtocol5(p provider.Provider, opts ...ServerOpt) func() tfprotov5.ProviderServer {
 	return func() tfprotov5.ProviderServer {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol5Server(p, opts)
 	}
 }
 
 // NewProtocol5WithError returns a protocol version 5 ProviderServer
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV5ProviderFactories.
+// ServerOpt may be given to configure the same server behaviors as the Serve
+// function ServeOpts.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer, error) {
+func NewProtocol5WithError(p provider.Provider, opts ...ServerOpt) func() (tfprotov5.ProviderServer, error) {
 	return func() (tfprotov5.ProviderServer, error) {
-		return &proto5server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol5Server(p, opts), nil
 	}
 }
 
 // NewProtocol6 returns a protocol version 6 ProviderServer implementation
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
-// function and various terraform-plugin-mux functions.
-func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
+// function and various terraform-plugin-mux functions. ServerOpt may be
+// given to configure the same server behaviors as the Serve function ServeOpts.
+func NewProtocol6(p provider.Provider, opts ...ServerOpt) func() tfprotov6.ProviderServer {
 	return func() tfprotov6.ProviderServer {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}
+		return newProtocol6Server(p, opts)
 	}
 }
 
 // NewProtocol6WithError returns a protocol version 6 ProviderServer
 // implementation based on the given Provider and suitable for usage with
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV6ProviderFactories.
+// ServerOpt may be given to configure the same server behaviors as the Serve
+// function ServeOpts.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
+func NewProtocol6WithError(p provider.Provider, opts ...ServerOpt) func() (tfprotov6.ProviderServer, error) {
 	return func() (tfprotov6.ProviderServer, error) {
-		return &proto6server.Server{
-			FrameworkServer: fwserver.Server{
-				Provider: p,
-			},
-		}, nil
+		return newProtocol6Server(p, opts), nil
 	}
 }
 
@@ -94,13 +81,7 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
-				provider := providerFunc()
-
-				return &proto5server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol5Server(providerFunc(), opts.serverOpts())
 			},
 			tf5serverOpts...,
 		)
@@ -114,13 +95,7 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 		return tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
-				provider := providerFunc()
-
-				return &proto6server.Server{
-					FrameworkServer: fwserver.Server{
-						Provider: provider,
-					},
-				}
+				return newProtocol6Server(providerFunc(), opts.serverOpts())
 			},
 			tf6serverOpts...,
 		)
@@ -4,12 +4,22 @@
 package providerserver
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
 )
 
 func TestNewProtocol5(t *testing.T) {
@@ -81,3 +91,330 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol6_WithInconsistentResultWarnings(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testProvider := &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = schema.Schema{
+								Attributes: map[string]schema.Attribute{
+									"test_required": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+							resp.TypeName = "test_resource"
+						},
+						UpdateMethod: func(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+							// Intentionally returning no resource state.
+							resp.State.RemoveResource(ctx)
+						},
+					}
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		opts                []ServerOpt
+		expectedDiagnostics []*tfprotov6.Diagnostic
+	}{
+		"none": {
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Missing Resource State After Update",
+					Detail: "The Terraform Provider unexpectedly returned no resource state after having no errors in the resource update. " +
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+				},
+			},
+		},
+		"WithInconsistentResultWarnings": {
+			opts: []ServerOpt{WithInconsistentResultWarnings()},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Missing Resource State After Update",
+					Detail: "The Terraform Provider unexpectedly returned no resource state after having no errors in the resource update. " +
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerServer := NewProtocol6(testProvider, testCase.opts...)()
+
+			resp, err := providerServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+				}),
+				PriorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+				}),
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error calling ProviderServer: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProtocol6_WithDiagnosticsFilter(t *testing.T) {
+	t.Parallel()
+
+	testProvider := &testprovider.Provider{
+		ConfigureMethod: func(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+			resp.Diagnostics.AddWarning("Advisory", "test advisory detail")
+			resp.Diagnostics.AddWarning("Other Warning", "test warning detail")
+		},
+	}
+
+	testFilter := func(d diag.Diagnostic) bool {
+		return d.Summary() == "Advisory"
+	}
+
+	testCases := map[string]struct {
+		opts                []ServerOpt
+		expectedDiagnostics []*tfprotov6.Diagnostic
+	}{
+		"none": {
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Advisory",
+					Detail:   "test advisory detail",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Other Warning",
+					Detail:   "test warning detail",
+				},
+			},
+		},
+		"WithDiagnosticsFilter": {
+			opts: []ServerOpt{WithDiagnosticsFilter(testFilter)},
+			expectedDiagnostics: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Other Warning",
+					Detail:   "test warning detail",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerServer := NewProtocol6(testProvider, testCase.opts...)()
+
+			resp, err := providerServer.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error calling ProviderServer: %s", err)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProtocol6_WithTraceDataLogging(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProvider := &testprovider.Provider{
+		SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+			resp.Schema = providerschema.Schema{
+				Attributes: map[string]providerschema.Attribute{
+					"test_attribute": providerschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		opts           []ServerOpt
+		expectedLogged bool
+	}{
+		"none": {
+			expectedLogged: false,
+		},
+		"WithTraceDataLogging": {
+			opts:           []ServerOpt{WithTraceDataLogging()},
+			expectedLogged: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+
+			providerServer := NewProtocol6(testProvider, testCase.opts...)()
+
+			resp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_attribute": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error calling ProviderServer: %s", err)
+			}
+
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+			}
+
+			if got := strings.Contains(output.String(), "test-config-value"); got != testCase.expectedLogged {
+				t.Errorf("expected configuration value logged %t, got logs: %s", testCase.expectedLogged, output.String())
+			}
+		})
+	}
+}
+
+func TestNewProtocol6_WithUpdateNewStateFromPlan(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+		},
+	}
+
+	testProvider := &testprovider.Provider{
+		ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+			return []func() resource.Resource{
+				func() resource.Resource {
+					return &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = schema.Schema{
+								Attributes: map[string]schema.Attribute{
+									"test_required": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							}
+						},
+						MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+							resp.TypeName = "test_resource"
+						},
+						UpdateMethod: func(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+							// Intentionally not setting the new state.
+						},
+					}
+				},
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		opts             []ServerOpt
+		expectedNewState *tfprotov6.DynamicValue
+	}{
+		"none": {
+			expectedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+			}),
+		},
+		"WithUpdateNewStateFromPlan": {
+			opts: []ServerOpt{WithUpdateNewStateFromPlan()},
+			expectedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+				"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerServer := NewProtocol6(testProvider, testCase.opts...)()
+
+			resp, err := providerServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+				}),
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+				}),
+				PriorState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_required": tftypes.NewValue(tftypes.String, "test-priorstate-value"),
+				}),
+				TypeName: "test_resource",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error calling ProviderServer: %s", err)
+			}
+
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %#v", resp.Diagnostics)
+			}
+
+			if diff := cmp.Diff(resp.NewState, testCase.expectedNewState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func testNewDynamicValue(t *testing.T, schemaType tftypes.Object, schemaValue map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, schemaValue))
+
+	if err != nil {
+		t.Fatalf("unable to create DynamicValue: %s", err)
+	}
+
+	return &dynamicValue
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 // ServeOpts are options for serving the provider.
@@ -24,6 +26,22 @@ type ServeOpts struct {
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
 
+	// DiagnosticsFilter removes warning diagnostics from the responses sent
+	// to Terraform when it returns true, such as advisory warnings which are
+	// not relevant in certain environments. Error diagnostics are never
+	// removed. Defaults to no filtering. Use the WithDiagnosticsFilter
+	// ServerOpt for the same behavior with the NewProtocol5 and NewProtocol6
+	// functions.
+	DiagnosticsFilter func(diag.Diagnostic) bool
+
+	// InconsistentResultWarnings returns the framework checks of unexpected
+	// resource results as warning diagnostics instead of error diagnostics.
+	// These checks cover missing resource state after create or update and
+	// resource state planned for destruction. Defaults to error diagnostics.
+	// Use the WithInconsistentResultWarnings ServerOpt for the same behavior
+	// with the NewProtocol5 and NewProtocol6 functions.
+	InconsistentResultWarnings bool
+
 	// ProtocolVersion is the protocol version that should be used when serving
 	// the provider. Either protocol version 5 or protocol version 6 can be
 	// used. Defaults to protocol version 6.
@@ -34,6 +52,25 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// TraceDataLogging logs the decoded configuration, plan, and state data
+	// of resource, data source, and provider RPCs at trace level, which can
+	// help troubleshoot data handling issues. Sensitive attribute values are
+	// always redacted. Defaults to disabled. Logs are only output when the
+	// TF_LOG_SDK_FRAMEWORK environment variable, or a more general log level
+	// environment variable, is set to TRACE. Use the WithTraceDataLogging
+	// ServerOpt for the same behavior with the NewProtocol5 and NewProtocol6
+	// functions.
+	TraceDataLogging bool
+
+	// UpdateNewStateFromPlan pre-populates the resource UpdateResponse State
+	// with the planned state instead of the prior state. When enabled, a
+	// resource Update method which does not call State.Set returns the
+	// planned state as the new state. Defaults to the prior state, which
+	// requires Update methods to explicitly set the new state. Use the
+	// WithUpdateNewStateFromPlan ServerOpt for the same behavior with the
+	// NewProtocol5 and NewProtocol6 functions.
+	UpdateNewStateFromPlan bool
 }
 
 // Validate a given provider address. This is only used for the Address field
@@ -85,3 +122,26 @@ func (opts ServeOpts) validate(ctx context.Context) error {
 
 	return nil
 }
+
+// serverOpts returns the ServerOpt equivalents of the ServeOpts fields.
+func (opts ServeOpts) serverOpts() []ServerOpt {
+	var serverOpts []ServerOpt
+
+	if opts.DiagnosticsFilter != nil {
+		serverOpts = append(serverOpts, WithDiagnosticsFilter(opts.DiagnosticsFilter))
+	}
+
+	if opts.InconsistentResultWarnings {
+		serverOpts = append(serverOpts, WithInconsistentResultWarnings())
+	}
+
+	if opts.TraceDataLogging {
+		serverOpts = append(serverOpts, WithTraceDataLogging())
+	}
+
+	if opts.UpdateNewStateFromPlan {
+		serverOpts = append(serverOpts, WithUpdateNewStateFromPlan())
+	}
+
+	return serverOpts
+}
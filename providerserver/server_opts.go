@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ServerOpt is an option for the provider server returned by the
+// NewProtocol5, NewProtocol5WithError, NewProtocol6, and
+// NewProtocol6WithError functions. These options match ServeOpts fields, so
+// provider servers used with terraform-plugin-mux or terraform-plugin-testing
+// can behave the same as the provider served with the Serve function.
+type ServerOpt func(*serverOpts)
+
+// serverOpts is the configuration of the provider server, which is set by
+// ServerOpt and copied into the framework server.
+type serverOpts struct {
+	diagnosticsFilter          func(diag.Diagnostic) bool
+	inconsistentResultWarnings bool
+	traceDataLogging           bool
+	updateNewStateFromPlan     bool
+}
+
+// WithDiagnosticsFilter removes warning diagnostics from the responses sent
+// to Terraform when the given function returns true. Error diagnostics are
+// never removed. Refer to the ServeOpts type DiagnosticsFilter field for more
+// information.
+func WithDiagnosticsFilter(filter func(diag.Diagnostic) bool) ServerOpt {
+	return func(opts *serverOpts) {
+		opts.diagnosticsFilter = filter
+	}
+}
+
+// WithInconsistentResultWarnings returns the framework checks of unexpected
+// resource results as warning diagnostics instead of error diagnostics. Refer
+// to the ServeOpts type InconsistentResultWarnings field for more
+// information.
+func WithInconsistentResultWarnings() ServerOpt {
+	return func(opts *serverOpts) {
+		opts.inconsistentResultWarnings = true
+	}
+}
+
+// WithTraceDataLogging logs the decoded configuration, plan, and state data
+// of resource, data source, and provider RPCs at trace level. Refer to the
+// ServeOpts type TraceDataLogging field for more information.
+func WithTraceDataLogging() ServerOpt {
+	return func(opts *serverOpts) {
+		opts.traceDataLogging = true
+	}
+}
+
+// WithUpdateNewStateFromPlan pre-populates the resource Update response state
+// with the planned state instead of the prior state. Refer to the ServeOpts
+// type UpdateNewStateFromPlan field for more information.
+func WithUpdateNewStateFromPlan() ServerOpt {
+	return func(opts *serverOpts) {
+		opts.updateNewStateFromPlan = true
+	}
+}
+
+// frameworkServer returns the framework server for the given provider with
+// the options applied.
+func (opts serverOpts) frameworkServer(p provider.Provider) fwserver.Server {
+	return fwserver.Server{
+		DiagnosticsFilter:          opts.diagnosticsFilter,
+		InconsistentResultWarnings: opts.inconsistentResultWarnings,
+		Provider:                   p,
+		TraceDataLogging:           opts.traceDataLogging,
+		UpdateNewStateFromPlan:     opts.updateNewStateFromPlan,
+	}
+}
+
+// newServerOpts returns the provider server configuration with all given
+// options applied.
+func newServerOpts(opts []ServerOpt) serverOpts {
+	var result serverOpts
+
+	for _, opt := range opts {
+		opt(&result)
+	}
+
+	return result
+}
+
+// newProtocol5Server returns the protocol version 5 server for the given
+// provider with all options applied.
+func newProtocol5Server(p provider.Provider, opts []ServerOpt) *proto5server.Server {
+	return &proto5server.Server{
+		FrameworkServer: newServerOpts(opts).frameworkServer(p),
+	}
+}
+
+// newProtocol6Server returns the protocol version 6 server for the given
+// provider with all options applied.
+func newProtocol6Server(p provider.Provider, opts []ServerOpt) *proto6server.Server {
+	return &proto6server.Server{
+		FrameworkServer: newServerOpts(opts).frameworkServer(p),
+	}
+}
@@ -25,6 +25,14 @@ type CreateRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// Private is provider-defined resource private state data which was set
+	// during the resource's ModifyPlan operation. Any existing data is copied
+	// to CreateResponse.Private to prevent accidental private state data loss.
+	//
+	// Use the GetKey method to read data. Use the SetKey method on
+	// CreateResponse.Private to update or remove a value.
+	Private *privatestate.ProviderData
 }
 
 // CreateResponse represents a response to a CreateRequest. An
@@ -38,8 +46,8 @@ type CreateResponse struct {
 	State tfsdk.State
 
 	// Private is the private state resource data following the Create operation.
-	// This field is not pre-populated as there is no pre-existing private state
-	// data during the resource's Create operation.
+	// This field is pre-populated from CreateRequest.Private and
+	// can be modified during the resource's Create operation.
 	Private *privatestate.ProviderData
 
 	// Diagnostics report errors or warnings related to creating the
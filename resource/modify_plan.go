@@ -41,6 +41,26 @@ type ModifyPlanRequest struct {
 	Private *privatestate.ProviderData
 }
 
+// IsCreate returns true if the request is planning the creation of the
+// resource, which is determined by a null State and non-null Plan.
+func (r ModifyPlanRequest) IsCreate() bool {
+	return r.State.Raw.IsNull() && !r.Plan.Raw.IsNull()
+}
+
+// IsDestroy returns true if the request is planning the destruction of the
+// resource, which is determined by a null Plan. Terraform 1.3 and later
+// supports resource destroy planning.
+func (r ModifyPlanRequest) IsDestroy() bool {
+	return r.Plan.Raw.IsNull()
+}
+
+// IsUpdate returns true if the request is planning an in-place update or no
+// change of an existing resource, which is determined by a non-null State and
+// non-null Plan.
+func (r ModifyPlanRequest) IsUpdate() bool {
+	return !r.State.Raw.IsNull() && !r.Plan.Raw.IsNull()
+}
+
 // ModifyPlanResponse represents a response to a
 // ModifyPlanRequest. An instance of this response struct is supplied
 // as an argument to the resource's ModifyPlan function, in which the provider
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestModifyPlanRequestOperation(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+	testNullValue := tftypes.NewValue(testType, nil)
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	type operation struct {
+		IsCreate  bool
+		IsDestroy bool
+		IsUpdate  bool
+	}
+
+	testCases := map[string]struct {
+		request  resource.ModifyPlanRequest
+		expected operation
+	}{
+		"create": {
+			request: resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testNullValue,
+					Schema: testSchema,
+				},
+			},
+			expected: operation{
+				IsCreate: true,
+			},
+		},
+		"destroy": {
+			request: resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testNullValue,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+			},
+			expected: operation{
+				IsDestroy: true,
+			},
+		},
+		"update": {
+			request: resource.ModifyPlanRequest{
+				Plan: tfsdk.Plan{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+			},
+			expected: operation{
+				IsUpdate: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := operation{
+				IsCreate:  testCase.request.IsCreate(),
+				IsDestroy: testCase.request.IsDestroy(),
+				IsUpdate:  testCase.request.IsUpdate(),
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
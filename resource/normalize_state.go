@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// NormalizeStateRequest represents a request to normalize the new state of a
+// resource after it has been created or updated. An instance of this request
+// struct is supplied as an argument to the Resource NormalizeState receiver
+// method.
+type NormalizeStateRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	Config tfsdk.Config
+
+	// Plan is the planned state for the resource.
+	Plan tfsdk.Plan
+
+	// State is the new state of the resource, as returned by the Create or
+	// Update method after any semantic equality updates. This is the same
+	// value as the NormalizeStateResponse type State field before any
+	// changes.
+	State tfsdk.State
+}
+
+// NormalizeStateResponse represents a response to a NormalizeStateRequest.
+// An instance of this response struct is supplied as an argument to the
+// Resource NormalizeState receiver method.
+type NormalizeStateResponse struct {
+	// State is the normalized new state of the resource. This field is
+	// pre-populated from NormalizeStateRequest.State. Any changes are
+	// returned to Terraform instead of the Create or Update method state.
+	State tfsdk.State
+
+	// Diagnostics report errors or warnings related to normalizing the
+	// resource state. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -39,6 +39,11 @@ type ReadResponse struct {
 	// State is the state of the resource following the Read operation.
 	// This field is pre-populated from ReadRequest.State and
 	// should be set during the resource's Read operation.
+	//
+	// If the resource no longer exists or is in an unrecoverable state,
+	// call the State.RemoveResource method. Terraform will then remove the
+	// resource from state and plan to create it. The protocol does not
+	// support requesting resource replacement from Read.
 	State tfsdk.State
 
 	// Private is the private state resource data following the Read operation.
@@ -156,6 +156,22 @@ type ResourceWithMoveState interface {
 	MoveState(context.Context) []StateMover
 }
 
+// ResourceWithNormalizeState is an interface type that extends Resource to
+// include normalization of the new state after Create or Update, such as
+// sorting list elements or converting string values to a canonical form in
+// a single place.
+//
+// NormalizeState is called after the Create or Update method returns without
+// error diagnostics and after any semantic equality updates, so the normalized
+// state is returned to Terraform as-is and must still be consistent with the
+// plan. It is not called after Delete.
+type ResourceWithNormalizeState interface {
+	Resource
+
+	// NormalizeState performs the normalization.
+	NormalizeState(context.Context, NormalizeStateRequest, *NormalizeStateResponse)
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the UpgradeResourceState RPC. This RPC is automatically called by Terraform
 // when the current Schema type Version field is greater than the stored state.
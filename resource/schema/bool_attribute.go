@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = BoolAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = BoolAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = BoolAttribute{}
 	_ fwschema.AttributeWithBoolDefaultValue       = BoolAttribute{}
 	_ fwxschema.AttributeWithBoolPlanModifiers     = BoolAttribute{}
@@ -152,6 +153,12 @@ type BoolAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Bool
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -200,6 +207,11 @@ func (a BoolAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a BoolAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.StringType or the CustomType field value if defined.
 func (a BoolAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -237,4 +249,8 @@ func (a BoolAttribute) ValidateImplementation(ctx context.Context, req fwschema.
 	if !a.IsComputed() && a.BoolDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -342,6 +342,40 @@ func TestBoolAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.BoolAttribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.BoolAttribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -559,6 +593,36 @@ func TestBoolAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.BoolAttribute{
+				Computed:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.BoolAttribute{
+				Optional:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,3 +41,8 @@ func (d staticBoolDefault) MarkdownDescription(_ context.Context) string {
 func (d staticBoolDefault) DefaultBool(_ context.Context, req defaults.BoolRequest, resp *defaults.BoolResponse) {
 	resp.PlanValue = types.BoolValue(d.defaultVal)
 }
+
+// StaticValue returns the static default value.
+func (d staticBoolDefault) StaticValue(_ context.Context) attr.Value {
+	return types.BoolValue(d.defaultVal)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// DependsOn is an optional interface on schema default value implementations
+// which determine the default value from the planned values of other
+// attributes. The framework sets these default values after all other default
+// values, in dependency order, so the planned values of the declared
+// attributes include their own default values. The planned values are
+// available in the DependencyValues field of the request. Dependency cycles
+// between default values raise an error diagnostic. A default value on a
+// parent attribute replaces these default values. Attributes under set
+// elements whose values are changed by other default values cannot be found
+// again, which raises an error diagnostic.
+type DependsOn interface {
+	// DependsOn should return the path expressions of the attributes whose
+	// planned values are used to set the default value. Relative expressions
+	// are resolved from the attribute path.
+	DependsOn(ctx context.Context) path.Expressions
+}
+
+// DependencyValue is the planned value of an attribute declared by the
+// DependsOn method of the default value implementation.
+type DependencyValue struct {
+	// Path is the path of the attribute.
+	Path path.Path
+
+	// Value is the planned value of the attribute.
+	Value attr.Value
+}
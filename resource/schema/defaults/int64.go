@@ -23,6 +23,11 @@ type Int64Request struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
+
+	// DependencyValues contains the planned values of the attributes
+	// declared by the DependsOn method, if the default value implements
+	// the DependsOn interface.
+	DependencyValues []DependencyValue
 }
 
 type Int64Response struct {
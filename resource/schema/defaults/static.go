@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Static is an optional interface on schema default value implementations
+// which always set the same value, regardless of the request. Implementing
+// this interface enables provider tooling, such as documentation generation,
+// to retrieve the default value without running plan logic.
+type Static interface {
+	// StaticValue should return the value set by the default.
+	StaticValue(ctx context.Context) attr.Value
+}
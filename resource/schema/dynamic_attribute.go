@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = DynamicAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = DynamicAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = DynamicAttribute{}
 	_ fwschema.AttributeWithDynamicDefaultValue    = DynamicAttribute{}
 	_ fwxschema.AttributeWithDynamicPlanModifiers  = DynamicAttribute{}
@@ -153,6 +154,12 @@ type DynamicAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Dynamic
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -186,6 +193,11 @@ func (a DynamicAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a DynamicAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.DynamicType or the CustomType field value if defined.
 func (a DynamicAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -238,4 +250,8 @@ func (a DynamicAttribute) ValidateImplementation(ctx context.Context, req fwsche
 	if !a.IsComputed() && a.DynamicDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -227,6 +227,40 @@ func TestDynamicAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestDynamicAttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.DynamicAttribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.DynamicAttribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.DynamicAttribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestDynamicAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -559,6 +593,36 @@ func TestDynamicAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.DynamicAttribute{
+				Computed:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.DynamicAttribute{
+				Optional:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
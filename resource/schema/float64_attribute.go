@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = Float64Attribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = Float64Attribute{}
 	_ fwschema.AttributeWithValidateImplementation = Float64Attribute{}
 	_ fwschema.AttributeWithFloat64DefaultValue    = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64PlanModifiers  = Float64Attribute{}
@@ -155,6 +156,12 @@ type Float64Attribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Float64
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -203,6 +210,11 @@ func (a Float64Attribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a Float64Attribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.Float64Type or the CustomType field value if defined.
 func (a Float64Attribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -240,4 +252,8 @@ func (a Float64Attribute) ValidateImplementation(ctx context.Context, req fwsche
 	if !a.IsComputed() && a.Float64DefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -341,6 +341,40 @@ func TestFloat64AttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.Float64Attribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.Float64Attribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -558,6 +592,36 @@ func TestFloat64AttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.Float64Attribute{
+				Computed:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.Float64Attribute{
+				Optional:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,3 +41,8 @@ func (d staticFloat64Default) MarkdownDescription(_ context.Context) string {
 func (d staticFloat64Default) DefaultFloat64(_ context.Context, req defaults.Float64Request, resp *defaults.Float64Response) {
 	resp.PlanValue = types.Float64Value(d.defaultVal)
 }
+
+// StaticValue returns the static default value.
+func (d staticFloat64Default) StaticValue(_ context.Context) attr.Value {
+	return types.Float64Value(d.defaultVal)
+}
@@ -226,6 +226,40 @@ func TestInt64AttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestInt64AttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Int64Attribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.Int64Attribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.Int64Attribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64AttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -558,6 +592,36 @@ func TestInt64AttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.Int64Attribute{
+				Computed:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.Int64Attribute{
+				Optional:      true,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,3 +41,8 @@ func (d staticInt64Default) MarkdownDescription(_ context.Context) string {
 func (d staticInt64Default) DefaultInt64(_ context.Context, req defaults.Int64Request, resp *defaults.Int64Response) {
 	resp.PlanValue = types.Int64Value(d.defaultVal)
 }
+
+// StaticValue returns the static default value.
+func (d staticInt64Default) StaticValue(_ context.Context) attr.Value {
+	return types.Int64Value(d.defaultVal)
+}
@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = ListAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = ListAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
 	_ fwschema.AttributeWithListDefaultValue       = ListAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListAttribute{}
@@ -168,6 +169,12 @@ type ListAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.List
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a list
@@ -201,6 +208,11 @@ func (a ListAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a ListAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.ListType or the CustomType field value if defined.
 func (a ListAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -286,4 +298,8 @@ func (a ListAttribute) ValidateImplementation(ctx context.Context, req fwschema.
 			resp.Diagnostics.Append(fwschema.AttributeDefaultElementTypeMismatchDiag(req.Path, a.ElementType, defaultResp.PlanValue.ElementType(ctx)))
 		}
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -233,6 +233,40 @@ func TestListAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestListAttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.ListAttribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.ListAttribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -711,6 +745,38 @@ func TestListAttributeValidateImplementation(t *testing.T) {
 				},
 			},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.ListAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.ListAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
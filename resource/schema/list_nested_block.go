@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Block                                    = ListNestedBlock{}
+	_ fwschema.BlockWithItemBounds             = ListNestedBlock{}
 	_ fwschema.BlockWithValidateImplementation = ListNestedBlock{}
 	_ fwxschema.BlockWithListPlanModifiers     = ListNestedBlock{}
 	_ fwxschema.BlockWithListValidators        = ListNestedBlock{}
@@ -120,6 +121,25 @@ type ListNestedBlock struct {
 	//
 	DeprecationMessage string
 
+	// MinItems is the minimum number of blocks which must be configured,
+	// which is reported to Terraform in the schema. Terraform enforces this
+	// before the provider receives the configuration, so practitioners will
+	// not receive other configuration errors until it is satisfied. The
+	// framework also enforces this during configuration validation, which
+	// covers an unknown number of blocks during Terraform validation, such as
+	// dynamic blocks. Zero means no minimum. Prefer the
+	// listvalidator.SizeAtLeast validator, which is returned with all other
+	// configuration errors.
+	MinItems int64
+
+	// MaxItems is the maximum number of blocks which can be configured, which
+	// is reported to Terraform in the schema. Terraform enforces this before
+	// the provider receives the configuration. The framework also enforces
+	// this during configuration validation. Zero means no maximum. Prefer the
+	// listvalidator.SizeAtMost validator, which is returned with all other
+	// configuration errors.
+	MaxItems int64
+
 	// Validators define value validation functionality for the attribute. All
 	// elements of the slice of AttributeValidator are run, regardless of any
 	// previous error diagnostics.
@@ -187,6 +207,16 @@ func (b ListNestedBlock) GetMarkdownDescription() string {
 	return b.MarkdownDescription
 }
 
+// GetMaxItems returns the MaxItems field value.
+func (b ListNestedBlock) GetMaxItems() int64 {
+	return b.MaxItems
+}
+
+// GetMinItems returns the MinItems field value.
+func (b ListNestedBlock) GetMinItems() int64 {
+	return b.MinItems
+}
+
 // GetNestedObject returns the NestedObject field value.
 func (b ListNestedBlock) GetNestedObject() fwschema.NestedBlockObject {
 	return b.NestedObject
@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = MapAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = MapAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = MapAttribute{}
 	_ fwschema.AttributeWithMapDefaultValue        = MapAttribute{}
 	_ fwxschema.AttributeWithMapPlanModifiers      = MapAttribute{}
@@ -171,6 +172,12 @@ type MapAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Map
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a map
@@ -204,6 +211,11 @@ func (a MapAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a MapAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.MapType or the CustomType field value if defined.
 func (a MapAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -289,4 +301,8 @@ func (a MapAttribute) ValidateImplementation(ctx context.Context, req fwschema.V
 			resp.Diagnostics.Append(fwschema.AttributeDefaultElementTypeMismatchDiag(req.Path, a.ElementType, defaultResp.PlanValue.ElementType(ctx)))
 		}
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -233,6 +233,40 @@ func TestMapAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestMapAttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.MapAttribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.MapAttribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.MapAttribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -710,6 +744,38 @@ func TestMapAttributeValidateImplementation(t *testing.T) {
 				},
 			},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.MapAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.MapAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,3 +41,8 @@ func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
 func (d staticValueDefault) DefaultMap(ctx context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
 	resp.PlanValue = d.defaultVal
 }
+
+// StaticValue returns the static default value.
+func (d staticValueDefault) StaticValue(_ context.Context) attr.Value {
+	return d.defaultVal
+}
@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = NumberAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = NumberAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = NumberAttribute{}
 	_ fwschema.AttributeWithNumberDefaultValue     = NumberAttribute{}
 	_ fwxschema.AttributeWithNumberPlanModifiers   = NumberAttribute{}
@@ -156,6 +157,12 @@ type NumberAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Number
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -189,6 +196,11 @@ func (a NumberAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a NumberAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.NumberType or the CustomType field value if defined.
 func (a NumberAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -241,4 +253,8 @@ func (a NumberAttribute) ValidateImplementation(ctx context.Context, req fwschem
 	if !a.IsComputed() && a.NumberDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -41,3 +42,8 @@ func (d staticBigFloatDefault) MarkdownDescription(_ context.Context) string {
 func (d staticBigFloatDefault) DefaultNumber(ctx context.Context, req defaults.NumberRequest, resp *defaults.NumberResponse) {
 	resp.PlanValue = types.NumberValue(d.defaultVal)
 }
+
+// StaticValue returns the static default value.
+func (d staticBigFloatDefault) StaticValue(_ context.Context) attr.Value {
+	return types.NumberValue(d.defaultVal)
+}
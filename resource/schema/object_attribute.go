@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = ObjectAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = ObjectAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ObjectAttribute{}
 	_ fwschema.AttributeWithObjectDefaultValue     = ObjectAttribute{}
 	_ fwxschema.AttributeWithObjectPlanModifiers   = ObjectAttribute{}
@@ -170,6 +171,12 @@ type ObjectAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Object
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into an
@@ -203,6 +210,11 @@ func (a ObjectAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a ObjectAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.ObjectType or the CustomType field value if defined.
 func (a ObjectAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -288,4 +300,8 @@ func (a ObjectAttribute) ValidateImplementation(ctx context.Context, req fwschem
 			resp.Diagnostics.Append(fwschema.AttributeDefaultTypeMismatchDiag(req.Path, a.GetType(), defaultResp.PlanValue.Type(ctx)))
 		}
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -92,6 +92,20 @@ func (s Schema) AttributeAtTerraformPath(ctx context.Context, p *tftypes.Attribu
 	return fwschema.SchemaAttributeAtTerraformPath(ctx, s, p)
 }
 
+// Equal returns true if the given Schema is a resource Schema and is
+// equivalent, including attributes, blocks, types, nesting, and
+// configurability options. Nil and empty attribute or block maps are
+// considered equal. Plan modifiers, defaults, and validators are not
+// compared. This method signature also enables go-cmp to use it when comparing
+// schemas, such as in provider unit tests with cmp.Diff.
+func (s Schema) Equal(o fwschema.Schema) bool {
+	if _, ok := o.(Schema); !ok {
+		return false
+	}
+
+	return fwschema.SchemasEqual(s, o)
+}
+
 // GetAttributes returns the Attributes field value.
 func (s Schema) GetAttributes() map[string]fwschema.Attribute {
 	return schemaAttributes(s.Attributes)
@@ -209,3 +223,16 @@ func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
 			"This is an issue with the provider and should be reported to the provider developers.",
 	)
 }
+
+// nonComputedAttributeWithMustSetOnReadDiag returns a diagnostic for use when
+// a non-computed attribute is using MustSetOnRead.
+func nonComputedAttributeWithMustSetOnReadDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+		fmt.Sprintf("Attribute %q must be computed when using MustSetOnRead. ", path.String())+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// AttributeDefault is the documentation of the default value declared on a
+// single attribute.
+type AttributeDefault struct {
+	// Path is the path expression of the attribute. Attributes underneath
+	// list, map, or set nesting are represented with any element expression
+	// steps, such as AtAnyListIndex.
+	Path path.Expression
+
+	// Description is the plaintext description of the default.
+	Description string
+
+	// MarkdownDescription is the Markdown description of the default.
+	MarkdownDescription string
+
+	// Value is the default value, if the default implements the
+	// defaults.Static interface, such as the stringdefault.StaticString
+	// default. Otherwise, Value is nil and Computed is true.
+	Value attr.Value
+
+	// Computed is true if the default value is only determined while
+	// running plan logic, such as a default implementation based on the
+	// request path. Documentation should indicate the value is computed.
+	Computed bool
+}
+
+// AttributeDefaults returns the default values declared on every attribute in
+// the schema, including nested attributes and attributes within blocks,
+// without running any plan logic. This is intended for provider tooling, such
+// as documentation generation. Attributes without a default are omitted. The
+// result is sorted by path expression.
+func (s Schema) AttributeDefaults(ctx context.Context) []AttributeDefault {
+	var result []AttributeDefault
+
+	for name, attribute := range s.GetAttributes() {
+		result = append(result, attributeDefaults(ctx, attribute, path.MatchRoot(name))...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		result = append(result, blockAttributeDefaults(ctx, block, path.MatchRoot(name))...)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path.String() < result[j].Path.String()
+	})
+
+	return result
+}
+
+// attributeDefaults returns the defaults of the attribute and any nested
+// attributes.
+func attributeDefaults(ctx context.Context, a fwschema.Attribute, expr path.Expression) []AttributeDefault {
+	var result []AttributeDefault
+
+	if d := attributeDefaultDescriber(a); d != nil {
+		attributeDefault := AttributeDefault{
+			Path:                expr,
+			Description:         d.Description(ctx),
+			MarkdownDescription: d.MarkdownDescription(ctx),
+		}
+
+		if static, ok := d.(defaults.Static); ok {
+			attributeDefault.Value = static.StaticValue(ctx)
+		} else {
+			attributeDefault.Computed = true
+		}
+
+		result = append(result, attributeDefault)
+	}
+
+	nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+	if !ok {
+		return result
+	}
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList:
+		expr = expr.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		expr = expr.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		expr = expr.AtAnySetValue()
+	case fwschema.NestingModeSingle:
+		// Nested attributes are directly underneath the attribute.
+	default:
+		return result
+	}
+
+	for name, nestedAttr := range nestedAttribute.GetNestedObject().GetAttributes() {
+		result = append(result, attributeDefaults(ctx, nestedAttr, expr.AtName(name))...)
+	}
+
+	return result
+}
+
+// blockAttributeDefaults returns the defaults of any attributes within the
+// block and its nested blocks.
+func blockAttributeDefaults(ctx context.Context, b fwschema.Block, expr path.Expression) []AttributeDefault {
+	var result []AttributeDefault
+
+	switch b.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		expr = expr.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		expr = expr.AtAnySetValue()
+	case fwschema.BlockNestingModeSingle:
+		// Nested attributes and blocks are directly underneath the block.
+	default:
+		return result
+	}
+
+	nestedObject := b.GetNestedObject()
+
+	for name, nestedAttr := range nestedObject.GetAttributes() {
+		result = append(result, attributeDefaults(ctx, nestedAttr, expr.AtName(name))...)
+	}
+
+	for name, nestedBlock := range nestedObject.GetBlocks() {
+		result = append(result, blockAttributeDefaults(ctx, nestedBlock, expr.AtName(name))...)
+	}
+
+	return result
+}
+
+// attributeDefaultDescriber returns the default of the attribute, regardless
+// of value type, or nil if no default is declared.
+func attributeDefaultDescriber(a fwschema.Attribute) defaults.Describer {
+	switch a := a.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		if d := a.BoolDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithDynamicDefaultValue:
+		if d := a.DynamicDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		if d := a.Float64DefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		if d := a.Int64DefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		if d := a.ListDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		if d := a.MapDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		if d := a.NumberDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		if d := a.ObjectDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		if d := a.SetDefaultValue(); d != nil {
+			return d
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		if d := a.StringDefaultValue(); d != nil {
+			return d
+		}
+	}
+
+	return nil
+}
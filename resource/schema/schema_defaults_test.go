@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaAttributeDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected []schema.AttributeDefault
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"attribute-no-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attribute-static": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+						Default:  stringdefault.StaticString("test-value"),
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test"),
+					Description:         "value defaults to test-value",
+					MarkdownDescription: "value defaults to `test-value`",
+					Value:               types.StringValue("test-value"),
+				},
+			},
+		},
+		"attribute-static-list": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListAttribute{
+						Computed:    true,
+						Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test-value")})),
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test"),
+					Description:         `value defaults to ["test-value"]`,
+					MarkdownDescription: "value defaults to `[\"test-value\"]`",
+					Value:               types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test-value")}),
+				},
+			},
+		},
+		"attribute-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Computed: true,
+						Default: testdefaults.String{
+							DescriptionMethod: func(_ context.Context) string {
+								return "value defaults to the attribute path"
+							},
+							MarkdownDescriptionMethod: func(_ context.Context) string {
+								return "value defaults to the attribute path"
+							},
+							DefaultStringMethod: func(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+								resp.PlanValue = types.StringValue(req.Path.String())
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test"),
+					Description:         "value defaults to the attribute path",
+					MarkdownDescription: "value defaults to the attribute path",
+					Computed:            true,
+				},
+			},
+		},
+		"attribute-sorted": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test2": schema.Int64Attribute{
+						Computed: true,
+						Default:  int64default.StaticInt64(2),
+						Optional: true,
+					},
+					"test1": schema.StringAttribute{
+						Computed: true,
+						Default:  stringdefault.StaticString("test-value"),
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test1"),
+					Description:         "value defaults to test-value",
+					MarkdownDescription: "value defaults to `test-value`",
+					Value:               types.StringValue("test-value"),
+				},
+				{
+					Path:                path.MatchRoot("test2"),
+					Description:         "value defaults to 2",
+					MarkdownDescription: "value defaults to `2`",
+					Value:               types.Int64Value(2),
+				},
+			},
+		},
+		"list-nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested": schema.StringAttribute{
+									Computed: true,
+									Default:  stringdefault.StaticString("test-value"),
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+					Description:         "value defaults to test-value",
+					MarkdownDescription: "value defaults to `test-value`",
+					Value:               types.StringValue("test-value"),
+				},
+			},
+		},
+		"single-nested-block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"nested": schema.StringAttribute{
+								Computed: true,
+								Default:  stringdefault.StaticString("test-value"),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test").AtName("nested"),
+					Description:         "value defaults to test-value",
+					MarkdownDescription: "value defaults to `test-value`",
+					Value:               types.StringValue("test-value"),
+				},
+			},
+		},
+		"set-nested-block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested": schema.StringAttribute{
+									Computed: true,
+									Default:  stringdefault.StaticString("test-value"),
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []schema.AttributeDefault{
+				{
+					Path:                path.MatchRoot("test").AtAnySetValue().AtName("nested"),
+					Description:         "value defaults to test-value",
+					MarkdownDescription: "value defaults to `test-value`",
+					Value:               types.StringValue("test-value"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.AttributeDefaults(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExtensionDescription is the documentation of a single plan modifier or
+// validator, as returned by its Description and MarkdownDescription methods.
+type ExtensionDescription struct {
+	// Description is the plaintext description.
+	Description string
+
+	// MarkdownDescription is the Markdown description.
+	MarkdownDescription string
+}
+
+// ExtensionDescriptions is the documentation of all plan modifiers and
+// validators declared on a single attribute or block.
+type ExtensionDescriptions struct {
+	// Path is the path expression of the attribute or block. Attributes and
+	// blocks underneath list, map, or set nesting are represented with any
+	// element expression steps, such as AtAnyListIndex.
+	Path path.Expression
+
+	// PlanModifiers contains the plan modifier descriptions in declaration
+	// order.
+	PlanModifiers []ExtensionDescription
+
+	// Validators contains the validator descriptions in declaration order.
+	Validators []ExtensionDescription
+}
+
+// ExtensionDescriptions returns the plan modifier and validator descriptions
+// of every attribute and block in the schema, including nested attributes and
+// blocks, without running any plan modification or validation logic. This is
+// intended for provider tooling, such as documentation generation. Attributes
+// and blocks without plan modifiers or validators are omitted. The result is
+// sorted by path expression.
+func (s Schema) ExtensionDescriptions(ctx context.Context) []ExtensionDescriptions {
+	var result []ExtensionDescriptions
+
+	for name, attribute := range s.GetAttributes() {
+		result = append(result, attributeExtensionDescriptions(ctx, attribute, path.MatchRoot(name))...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		result = append(result, blockExtensionDescriptions(ctx, block, path.MatchRoot(name))...)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path.String() < result[j].Path.String()
+	})
+
+	return result
+}
+
+// attributeExtensionDescriptions returns the descriptions of the attribute and
+// any nested attributes.
+func attributeExtensionDescriptions(ctx context.Context, a fwschema.Attribute, expr path.Expression) []ExtensionDescriptions {
+	var result []ExtensionDescriptions
+
+	descriptions := ExtensionDescriptions{
+		Path:          expr,
+		PlanModifiers: planModifierDescriptions(ctx, fwxschema.AttributePlanModifierDescribers(a)),
+		Validators:    validatorDescriptions(ctx, fwxschema.AttributeValidatorDescribers(a)),
+	}
+
+	if len(descriptions.PlanModifiers) > 0 || len(descriptions.Validators) > 0 {
+		result = append(result, descriptions)
+	}
+
+	nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+	if !ok {
+		return result
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList:
+		expr = expr.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		expr = expr.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		expr = expr.AtAnySetValue()
+	case fwschema.NestingModeSingle:
+		// Single nested attributes declare object plan modifiers and
+		// validators on the attribute itself, which are already included.
+		for name, nestedAttr := range nestedObject.GetAttributes() {
+			result = append(result, attributeExtensionDescriptions(ctx, nestedAttr, expr.AtName(name))...)
+		}
+
+		return result
+	default:
+		return result
+	}
+
+	objectDescriptions := ExtensionDescriptions{
+		Path: expr,
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
+		for _, m := range o.ObjectPlanModifiers() {
+			objectDescriptions.PlanModifiers = append(objectDescriptions.PlanModifiers, planModifierDescription(ctx, m))
+		}
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedAttributeObjectWithValidators); ok {
+		for _, v := range o.ObjectValidators() {
+			objectDescriptions.Validators = append(objectDescriptions.Validators, validatorDescription(ctx, v))
+		}
+	}
+
+	if len(objectDescriptions.PlanModifiers) > 0 || len(objectDescriptions.Validators) > 0 {
+		result = append(result, objectDescriptions)
+	}
+
+	for name, nestedAttr := range nestedObject.GetAttributes() {
+		result = append(result, attributeExtensionDescriptions(ctx, nestedAttr, expr.AtName(name))...)
+	}
+
+	return result
+}
+
+// blockExtensionDescriptions returns the descriptions of the block and any
+// nested attributes and blocks.
+func blockExtensionDescriptions(ctx context.Context, b fwschema.Block, expr path.Expression) []ExtensionDescriptions {
+	var result []ExtensionDescriptions
+
+	descriptions := ExtensionDescriptions{
+		Path:          expr,
+		PlanModifiers: planModifierDescriptions(ctx, fwxschema.BlockPlanModifierDescribers(b)),
+		Validators:    validatorDescriptions(ctx, fwxschema.BlockValidatorDescribers(b)),
+	}
+
+	if len(descriptions.PlanModifiers) > 0 || len(descriptions.Validators) > 0 {
+		result = append(result, descriptions)
+	}
+
+	nestedObject := b.GetNestedObject()
+
+	switch b.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		expr = expr.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		expr = expr.AtAnySetValue()
+	case fwschema.BlockNestingModeSingle:
+		// Single nested blocks declare object plan modifiers and validators
+		// on the block itself, which are already included.
+		for name, nestedAttr := range nestedObject.GetAttributes() {
+			result = append(result, attributeExtensionDescriptions(ctx, nestedAttr, expr.AtName(name))...)
+		}
+
+		for name, nestedBlock := range nestedObject.GetBlocks() {
+			result = append(result, blockExtensionDescriptions(ctx, nestedBlock, expr.AtName(name))...)
+		}
+
+		return result
+	default:
+		return result
+	}
+
+	objectDescriptions := ExtensionDescriptions{
+		Path: expr,
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedBlockObjectWithPlanModifiers); ok {
+		for _, m := range o.ObjectPlanModifiers() {
+			objectDescriptions.PlanModifiers = append(objectDescriptions.PlanModifiers, planModifierDescription(ctx, m))
+		}
+	}
+
+	if o, ok := nestedObject.(fwxschema.NestedBlockObjectWithValidators); ok {
+		for _, v := range o.ObjectValidators() {
+			objectDescriptions.Validators = append(objectDescriptions.Validators, validatorDescription(ctx, v))
+		}
+	}
+
+	if len(objectDescriptions.PlanModifiers) > 0 || len(objectDescriptions.Validators) > 0 {
+		result = append(result, objectDescriptions)
+	}
+
+	for name, nestedAttr := range nestedObject.GetAttributes() {
+		result = append(result, attributeExtensionDescriptions(ctx, nestedAttr, expr.AtName(name))...)
+	}
+
+	for name, nestedBlock := range nestedObject.GetBlocks() {
+		result = append(result, blockExtensionDescriptions(ctx, nestedBlock, expr.AtName(name))...)
+	}
+
+	return result
+}
+
+// planModifierDescription returns the ExtensionDescription of a plan modifier.
+func planModifierDescription(ctx context.Context, m planmodifier.Describer) ExtensionDescription {
+	return ExtensionDescription{
+		Description:         m.Description(ctx),
+		MarkdownDescription: m.MarkdownDescription(ctx),
+	}
+}
+
+// planModifierDescriptions returns the ExtensionDescription of each plan
+// modifier.
+func planModifierDescriptions(ctx context.Context, describers []planmodifier.Describer) []ExtensionDescription {
+	var result []ExtensionDescription
+
+	for _, m := range describers {
+		result = append(result, planModifierDescription(ctx, m))
+	}
+
+	return result
+}
+
+// validatorDescription returns the ExtensionDescription of a validator.
+func validatorDescription(ctx context.Context, v validator.Describer) ExtensionDescription {
+	return ExtensionDescription{
+		Description:         v.Description(ctx),
+		MarkdownDescription: v.MarkdownDescription(ctx),
+	}
+}
+
+// validatorDescriptions returns the ExtensionDescription of each validator.
+func validatorDescriptions(ctx context.Context, describers []validator.Describer) []ExtensionDescription {
+	var result []ExtensionDescription
+
+	for _, v := range describers {
+		result = append(result, validatorDescription(ctx, v))
+	}
+
+	return result
+}
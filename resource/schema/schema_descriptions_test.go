@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func TestSchemaExtensionDescriptions(t *testing.T) {
+	t.Parallel()
+
+	// Mirrors the stringvalidator.LengthBetween validator descriptions from
+	// the terraform-plugin-framework-validators Go module.
+	lengthBetween := testvalidator.String{
+		DescriptionMethod: func(_ context.Context) string {
+			return "string length must be between 1 and 10"
+		},
+		MarkdownDescriptionMethod: func(_ context.Context) string {
+			return "string length must be between 1 and 10"
+		},
+	}
+	requiresReplaceDescription := "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected []schema.ExtensionDescriptions
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"attribute-no-extensions": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+						Validators: []validator.String{
+							lengthBetween,
+						},
+					},
+				},
+			},
+			expected: []schema.ExtensionDescriptions{
+				{
+					Path: path.MatchRoot("test"),
+					PlanModifiers: []schema.ExtensionDescription{
+						{
+							Description:         requiresReplaceDescription,
+							MarkdownDescription: requiresReplaceDescription,
+						},
+					},
+					Validators: []schema.ExtensionDescription{
+						{
+							Description:         "string length must be between 1 and 10",
+							MarkdownDescription: "string length must be between 1 and 10",
+						},
+					},
+				},
+			},
+		},
+		"attribute-sorted": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test2": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							lengthBetween,
+						},
+					},
+					"test1": schema.StringAttribute{
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			expected: []schema.ExtensionDescriptions{
+				{
+					Path: path.MatchRoot("test1"),
+					PlanModifiers: []schema.ExtensionDescription{
+						{
+							Description:         requiresReplaceDescription,
+							MarkdownDescription: requiresReplaceDescription,
+						},
+					},
+				},
+				{
+					Path: path.MatchRoot("test2"),
+					Validators: []schema.ExtensionDescription{
+						{
+							Description:         "string length must be between 1 and 10",
+							MarkdownDescription: "string length must be between 1 and 10",
+						},
+					},
+				},
+			},
+		},
+		"list-nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested": schema.StringAttribute{
+									Required: true,
+									PlanModifiers: []planmodifier.String{
+										stringplanmodifier.RequiresReplace(),
+									},
+									Validators: []validator.String{
+										lengthBetween,
+									},
+								},
+							},
+						},
+						Optional: true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			expected: []schema.ExtensionDescriptions{
+				{
+					Path: path.MatchRoot("test"),
+					PlanModifiers: []schema.ExtensionDescription{
+						{
+							Description:         requiresReplaceDescription,
+							MarkdownDescription: requiresReplaceDescription,
+						},
+					},
+				},
+				{
+					Path: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+					PlanModifiers: []schema.ExtensionDescription{
+						{
+							Description:         requiresReplaceDescription,
+							MarkdownDescription: requiresReplaceDescription,
+						},
+					},
+					Validators: []schema.ExtensionDescription{
+						{
+							Description:         "string length must be between 1 and 10",
+							MarkdownDescription: "string length must be between 1 and 10",
+						},
+					},
+				},
+			},
+		},
+		"single-nested-block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"nested": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									lengthBetween,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []schema.ExtensionDescriptions{
+				{
+					Path: path.MatchRoot("test").AtName("nested"),
+					Validators: []schema.ExtensionDescription{
+						{
+							Description:         "string length must be between 1 and 10",
+							MarkdownDescription: "string length must be between 1 and 10",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.ExtensionDescriptions(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
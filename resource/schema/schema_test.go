@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -422,6 +423,274 @@ func TestSchemaAttributeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		other    fwschema.Schema
+		expected bool
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			other:    schema.Schema{},
+			expected: true,
+		},
+		"different-type": {
+			schema:   schema.Schema{},
+			other:    testschema.Schema{},
+			expected: false,
+		},
+		"nil-and-empty-attributes": {
+			schema: schema.Schema{},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{},
+			},
+			expected: true,
+		},
+		"attributes-equal": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: true,
+		},
+		"attributes-different-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr1": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr2": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-length": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+		"attributes-different-attribute-type": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-options": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional:  true,
+									Sensitive: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"attributes-different-nesting-mode": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			other: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"testattr": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: false,
+		},
+		"blocks-equal": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"blocks-different-nested-attributes": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"testblock": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"nil-and-empty-blocks": {
+			schema: schema.Schema{},
+			other: schema.Schema{
+				Blocks: map[string]schema.Block{},
+			},
+			expected: true,
+		},
+		"different-description": {
+			schema: schema.Schema{
+				Description: "test description",
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+		"different-deprecation-message": {
+			schema: schema.Schema{
+				DeprecationMessage: "test deprecation message",
+			},
+			other:    schema.Schema{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			// The Equal method should also be used by go-cmp.
+			if testCase.expected && !cmp.Equal(testCase.schema, testCase.other) {
+				t.Errorf("expected cmp.Equal to return true, got false")
+			}
+		})
+	}
+}
+
 func TestSchemaGetAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -1022,7 +1291,9 @@ func TestSchemaValidate(t *testing.T) {
 		"validate-implementation-error": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1065,7 +1336,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1099,8 +1372,11 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"depends_on": schema.BoolAttribute{},
+							"depends_on": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -1110,16 +1386,97 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"connection": schema.BoolAttribute{},
+							"connection": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"nested-attribute-required-under-optional-parent": {
+			// Required only applies when the parent value is configured.
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nested_attr": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"nested-attribute-computed-under-computed-parent": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nested_attr": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+						Computed: true,
+					},
+				},
+			},
+		},
+		"nested-attribute-required-under-computed-parent": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nested_attr": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Computed: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.nested_attr\" is Required or Optional, however the parent attribute \"single_nested_attribute\" is Computed without Optional, "+
+						"so the attribute can never be configured. Either set only Computed on the attribute, or set Optional on the parent attribute.",
+				),
+			},
+		},
+		"nested-attribute-optional-under-computed-parent": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list_nested_attribute": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
 						},
+						Computed: true,
 					},
 				},
 			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"list_nested_attribute.nested_attr\" is Required or Optional, however the parent attribute \"list_nested_attribute\" is Computed without Optional, "+
+						"so the attribute can never be configured. Either set only Computed on the attribute, or set Optional on the parent attribute.",
+				),
+			},
 		},
 		"attribute-and-blocks-using-reserved-field-names": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"depends_on": schema.StringAttribute{},
+					"depends_on": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"connection": schema.ListNestedBlock{},
@@ -1142,10 +1499,47 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"attribute-missing-required-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
+		"attribute-required-and-optional": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is both Required and Optional. "+
+						"Set only one of these fields, depending on whether the attribute must be configured.",
+				),
+			},
+		},
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
-					"^": schema.StringAttribute{},
+					"^": schema.StringAttribute{
+						Optional: true,
+					},
 				},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1174,13 +1568,37 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"nested-attribute-missing-required-optional-computed": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"single_nested_attribute": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"test": schema.StringAttribute{},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"single_nested_attribute.test\" is missing the Required, Optional, or Computed field. "+
+						"One of these fields must be set to declare whether the attribute is configured by practitioners or set by the provider.",
+				),
+			},
+		},
 		"nested-attribute-using-nested-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
 					"single_nested_attribute": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -1199,7 +1617,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				Blocks: map[string]schema.Block{
 					"single_nested_block": schema.SingleNestedBlock{
 						Attributes: map[string]schema.Attribute{
-							"^": schema.BoolAttribute{},
+							"^": schema.BoolAttribute{
+								Optional: true,
+							},
 						},
 					},
 				},
@@ -1221,7 +1641,9 @@ func TestSchemaValidateImplementation(t *testing.T) {
 						Blocks: map[string]schema.Block{
 							"^": schema.SingleNestedBlock{
 								Attributes: map[string]schema.Attribute{
-									"!": schema.BoolAttribute{},
+									"!": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -1281,6 +1703,7 @@ func TestSchemaValidateImplementation(t *testing.T) {
 								},
 							},
 						},
+						Optional: true,
 					},
 				},
 			},
@@ -1348,6 +1771,113 @@ func TestSchemaValidateImplementation(t *testing.T) {
 				),
 			},
 		},
+		"nested-attribute-min-items-greater-than-max-items": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+						MinItems: 2,
+						MaxItems: 1,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has invalid item bounds, MinItems: 2, MaxItems: 1. "+
+						"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+				),
+			},
+		},
+		"nested-attribute-min-items-without-max-items": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+						MinItems: 2,
+					},
+				},
+			},
+		},
+		"nested-attribute-negative-max-items": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+						MaxItems: -1,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has invalid item bounds, MinItems: 0, MaxItems: -1. "+
+						"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+				),
+			},
+		},
+		"block-min-items-greater-than-max-items": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.ListNestedBlock{
+						MinItems: 3,
+						MaxItems: 2,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Block Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has invalid item bounds, MinItems: 3, MaxItems: 2. "+
+						"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+				),
+			},
+		},
+		"block-negative-min-items": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test": schema.SetNestedBlock{
+						MinItems: -1,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Block Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" has invalid item bounds, MinItems: -1, MaxItems: 0. "+
+						"MinItems and MaxItems cannot be negative and MinItems cannot be greater than a non-zero MaxItems.",
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
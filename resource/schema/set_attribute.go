@@ -22,6 +22,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = SetAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = SetAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
 	_ fwschema.AttributeWithSetDefaultValue        = SetAttribute{}
 	_ fwxschema.AttributeWithSetPlanModifiers      = SetAttribute{}
@@ -166,6 +167,12 @@ type SetAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.Set
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a set
@@ -199,6 +206,11 @@ func (a SetAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a SetAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.SetType or the CustomType field value if defined.
 func (a SetAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -284,4 +296,8 @@ func (a SetAttribute) ValidateImplementation(ctx context.Context, req fwschema.V
 			resp.Diagnostics.Append(fwschema.AttributeDefaultElementTypeMismatchDiag(req.Path, a.ElementType, defaultResp.PlanValue.ElementType(ctx)))
 		}
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
@@ -232,6 +232,40 @@ func TestSetAttributeGetMarkdownDescription(t *testing.T) {
 	}
 }
 
+func TestSetAttributeGetMustSetOnRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.SetAttribute
+		expected  bool
+	}{
+		"no-must-set-on-read": {
+			attribute: schema.SetAttribute{},
+			expected:  false,
+		},
+		"must-set-on-read": {
+			attribute: schema.SetAttribute{
+				MustSetOnRead: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetMustSetOnRead()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetAttributeGetType(t *testing.T) {
 	t.Parallel()
 
@@ -699,6 +733,38 @@ func TestSetAttributeValidateImplementation(t *testing.T) {
 				},
 			},
 		},
+		"must-set-on-read-with-computed": {
+			attribute: schema.SetAttribute{
+				Computed:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"must-set-on-read-without-computed": {
+			attribute: schema.SetAttribute{
+				Optional:      true,
+				ElementType:   types.StringType,
+				MustSetOnRead: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Attribute MustSetOnRead For Non-Computed Attribute",
+						"Attribute \"test\" must be computed when using MustSetOnRead. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
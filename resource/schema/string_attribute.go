@@ -21,6 +21,7 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var (
 	_ Attribute                                    = StringAttribute{}
+	_ fwschema.AttributeWithMustSetOnRead          = StringAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = StringAttribute{}
 	_ fwschema.AttributeWithStringDefaultValue     = StringAttribute{}
 	_ fwxschema.AttributeWithStringPlanModifiers   = StringAttribute{}
@@ -152,6 +153,12 @@ type StringAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.String
+
+	// MustSetOnRead indicates whether the resource Read method is expected to
+	// refresh the value of this attribute every time, such as a last updated
+	// timestamp. If enabled, the framework raises a warning diagnostic when
+	// the value after Read is null or unknown. Computed must be true.
+	MustSetOnRead bool
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -185,6 +192,11 @@ func (a StringAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
 }
 
+// GetMustSetOnRead returns the MustSetOnRead field value.
+func (a StringAttribute) GetMustSetOnRead() bool {
+	return a.MustSetOnRead
+}
+
 // GetType returns types.StringType or the CustomType field value if defined.
 func (a StringAttribute) GetType() attr.Type {
 	if a.CustomType != nil {
@@ -237,4 +249,8 @@ func (a StringAttribute) ValidateImplementation(ctx context.Context, req fwschem
 	if !a.IsComputed() && a.StringDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
+
+	if !a.IsComputed() && a.MustSetOnRead {
+		resp.Diagnostics.Append(nonComputedAttributeWithMustSetOnReadDiag(req.Path))
+	}
 }
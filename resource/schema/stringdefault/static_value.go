@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,3 +41,8 @@ func (d staticStringDefault) MarkdownDescription(_ context.Context) string {
 func (d staticStringDefault) DefaultString(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
 	resp.PlanValue = types.StringValue(d.defaultVal)
 }
+
+// StaticValue returns the static default value.
+func (d staticStringDefault) StaticValue(_ context.Context) attr.Value {
+	return types.StringValue(d.defaultVal)
+}
@@ -45,3 +45,19 @@ func TestStaticStringDefaultString(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticStringStaticValue(t *testing.T) {
+	t.Parallel()
+
+	staticDefault, ok := stringdefault.StaticString("test-value").(defaults.Static)
+
+	if !ok {
+		t.Fatal("expected StaticString to implement defaults.Static")
+	}
+
+	got := staticDefault.StaticValue(context.Background())
+
+	if diff := cmp.Diff(got, types.StringValue("test-value")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SuppressCaseDifferences returns a plan modifier that copies a known prior
+// state value into the planned value if the values only differ by letter case.
+//
+// This only affects values set by the attribute Default when the attribute is
+// not configured. Use this when the remote system normalizes the letter case
+// of a default value, to prevent Terraform from showing a difference. Terraform
+// requires configured values to be preserved in the plan, so configured values
+// are never adjusted. To prevent differences for configured values, use a
+// custom type which implements semantic equality instead.
+func SuppressCaseDifferences() planmodifier.String {
+	return suppressCaseDifferencesModifier{}
+}
+
+// suppressCaseDifferencesModifier implements the plan modifier.
+type suppressCaseDifferencesModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m suppressCaseDifferencesModifier) Description(_ context.Context) string {
+	return "When this attribute is not configured, differences in letter case between the default value and the prior state value will not be shown in the plan."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m suppressCaseDifferencesModifier) MarkdownDescription(_ context.Context) string {
+	return "When this attribute is not configured, differences in letter case between the default value and the prior state value will not be shown in the plan."
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m suppressCaseDifferencesModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is a configuration value, which must be preserved.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is no known state or planned value to compare.
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
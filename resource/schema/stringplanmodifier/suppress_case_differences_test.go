@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressCaseDifferencesModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("TEST value"),
+				StateValue:  types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("TEST value"),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"null-plan": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringNull(),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"equal": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("Test Value"),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("Test Value"),
+			},
+		},
+		"case-difference": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("TEST value"),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("Test Value"),
+			},
+		},
+		"other-difference": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("Test Value 2"),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("Test Value 2"),
+			},
+		},
+		// Terraform requires configured values to be preserved in the plan.
+		"case-difference-configured": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("TEST value"),
+				PlanValue:   types.StringValue("TEST value"),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("TEST value"),
+			},
+		},
+		"case-difference-unknown-config": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringUnknown(),
+				PlanValue:   types.StringUnknown(),
+				StateValue:  types.StringValue("Test Value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.SuppressCaseDifferences().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
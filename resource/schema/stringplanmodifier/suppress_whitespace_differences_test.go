@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSuppressWhitespaceDifferencesModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"null-state": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue(" test\tvalue\n"),
+				StateValue:  types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(" test\tvalue\n"),
+			},
+		},
+		"unknown-plan": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"null-plan": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringNull(),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"equal": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("test value"),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test value"),
+			},
+		},
+		"whitespace-difference": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue(" test\tvalue\n"),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test value"),
+			},
+		},
+		"other-difference": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   types.StringValue("testvalue"),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("testvalue"),
+			},
+		},
+		// Terraform requires configured values to be preserved in the plan.
+		"whitespace-difference-configured": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue(" test\tvalue\n"),
+				PlanValue:   types.StringValue(" test\tvalue\n"),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(" test\tvalue\n"),
+			},
+		},
+		"whitespace-difference-unknown-config": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringUnknown(),
+				PlanValue:   types.StringUnknown(),
+				StateValue:  types.StringValue("test value"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.SuppressWhitespaceDifferences().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
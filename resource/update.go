@@ -45,8 +45,13 @@ type UpdateRequest struct {
 // should set values on the UpdateResponse as appropriate.
 type UpdateResponse struct {
 	// State is the state of the resource following the Update operation.
-	// This field is pre-populated from UpdateResourceRequest.Plan and
-	// should be set during the resource's Update operation.
+	// This field is pre-populated from UpdateRequest.State and should be set
+	// during the resource's Update operation, typically from
+	// UpdateRequest.Plan with any computed values populated. The value of
+	// this field after the Update method returns is the new resource state.
+	//
+	// If the provider server is configured with the UpdateNewStateFromPlan
+	// option, this field is instead pre-populated from UpdateRequest.Plan.
 	State tfsdk.State
 
 	// Private is the private state resource data following the Update operation.
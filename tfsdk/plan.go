@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -35,6 +36,33 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 	return p.data().GetAtPath(ctx, path, target)
 }
 
+// EmptyListAtPath returns a known, empty list value using the type of the
+// schema attribute or block at `path`. Custom types return their associated
+// custom value type, otherwise the value is a types.List. The value is
+// ready to be populated and written to the plan, which prevents mistakes
+// when manually declaring element types.
+func (p Plan) EmptyListAtPath(ctx context.Context, path path.Path) (basetypes.ListValuable, diag.Diagnostics) {
+	return p.data().EmptyListAtPath(ctx, path)
+}
+
+// EmptyMapAtPath returns a known, empty map value using the type of the
+// schema attribute at `path`. Custom types return their associated custom
+// value type, otherwise the value is a types.Map. The value is ready to be
+// populated and written to the plan, which prevents mistakes when manually
+// declaring element types.
+func (p Plan) EmptyMapAtPath(ctx context.Context, path path.Path) (basetypes.MapValuable, diag.Diagnostics) {
+	return p.data().EmptyMapAtPath(ctx, path)
+}
+
+// EmptySetAtPath returns a known, empty set value using the type of the
+// schema attribute or block at `path`. Custom types return their associated
+// custom value type, otherwise the value is a types.Set. The value is
+// ready to be populated and written to the plan, which prevents mistakes
+// when manually declaring element types.
+func (p Plan) EmptySetAtPath(ctx context.Context, path path.Path) (basetypes.SetValuable, diag.Diagnostics) {
+	return p.data().EmptySetAtPath(ctx, path)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
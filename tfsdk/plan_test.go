@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -123,6 +124,61 @@ func TestPlanGet(t *testing.T) {
 	}
 }
 
+func TestPlanEmptyListAtPath(t *testing.T) {
+	t.Parallel()
+
+	// Refer to fwschemadata.TestDataEmptyListAtPath for more exhaustive unit
+	// testing. This test case is to ensure Plan schema and data values are
+	// passed appropriately to the shared implementation.
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"tags": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"tags": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	got, diags := plan.EmptyListAtPath(context.Background(), path.Root("tags"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value (+wanted, -got): %s", diff)
+	}
+
+	diags = plan.SetAttribute(context.Background(), path.Root("tags"), got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expectedRaw := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}, map[string]tftypes.Value{
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+	})
+
+	if diff := cmp.Diff(plan.Raw, expectedRaw); diff != "" {
+		t.Errorf("unexpected raw value (+wanted, -got): %s", diff)
+	}
+}
+
 func TestPlanGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // State represents a Terraform state.
@@ -37,6 +38,33 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// EmptyListAtPath returns a known, empty list value using the type of the
+// schema attribute or block at `path`. Custom types return their associated
+// custom value type, otherwise the value is a types.List. The value is
+// ready to be populated and written to the state, which prevents mistakes
+// when manually declaring element types.
+func (s State) EmptyListAtPath(ctx context.Context, path path.Path) (basetypes.ListValuable, diag.Diagnostics) {
+	return s.data().EmptyListAtPath(ctx, path)
+}
+
+// EmptyMapAtPath returns a known, empty map value using the type of the
+// schema attribute at `path`. Custom types return their associated custom
+// value type, otherwise the value is a types.Map. The value is ready to be
+// populated and written to the state, which prevents mistakes when manually
+// declaring element types.
+func (s State) EmptyMapAtPath(ctx context.Context, path path.Path) (basetypes.MapValuable, diag.Diagnostics) {
+	return s.data().EmptyMapAtPath(ctx, path)
+}
+
+// EmptySetAtPath returns a known, empty set value using the type of the
+// schema attribute or block at `path`. Custom types return their associated
+// custom value type, otherwise the value is a types.Set. The value is
+// ready to be populated and written to the state, which prevents mistakes
+// when manually declaring element types.
+func (s State) EmptySetAtPath(ctx context.Context, path path.Path) (basetypes.SetValuable, diag.Diagnostics) {
+	return s.data().EmptySetAtPath(ctx, path)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -123,6 +124,61 @@ func TestStateGet(t *testing.T) {
 	}
 }
 
+func TestStateEmptyListAtPath(t *testing.T) {
+	t.Parallel()
+
+	// Refer to fwschemadata.TestDataEmptyListAtPath for more exhaustive unit
+	// testing. This test case is to ensure State schema and data values are
+	// passed appropriately to the shared implementation.
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"tags": tftypes.List{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"tags": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	got, diags := state.EmptyListAtPath(context.Background(), path.Root("tags"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value (+wanted, -got): %s", diff)
+	}
+
+	diags = state.SetAttribute(context.Background(), path.Root("tags"), got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expectedRaw := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}, map[string]tftypes.Value{
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+	})
+
+	if diff := cmp.Diff(state.Raw, expectedRaw); diff != "" {
+		t.Errorf("unexpected raw value (+wanted, -got): %s", diff)
+	}
+}
+
 func TestStateGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -392,3 +448,95 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateGetAttributeSetAttribute_listElement(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Name  types.String `tfsdk:"name"`
+		Value types.String `tfsdk:"value"`
+	}
+
+	elementType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"value": tftypes.String,
+		},
+	}
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.List{ElementType: elementType},
+		},
+	}
+
+	newElement := func(name string, value string) tftypes.Value {
+		return tftypes.NewValue(elementType, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, name),
+			"value": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.List{ElementType: elementType}, []tftypes.Value{
+				newElement("zero", "value0"),
+				newElement("one", "value1"),
+				newElement("two", "value2"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"test": testschema.NestedAttribute{
+					NestedObject: testschema.NestedAttributeObject{
+						Attributes: map[string]fwschema.Attribute{
+							"name": testschema.Attribute{
+								Required: true,
+								Type:     types.StringType,
+							},
+							"value": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					NestingMode: fwschema.NestingModeList,
+					Required:    true,
+				},
+			},
+		},
+	}
+
+	elementPath := path.Root("test").AtListIndex(1)
+
+	var got element
+
+	diags := state.GetAttribute(context.Background(), elementPath, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected GetAttribute diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, element{Name: types.StringValue("one"), Value: types.StringValue("value1")}); diff != "" {
+		t.Fatalf("unexpected GetAttribute value difference: %s", diff)
+	}
+
+	got.Value = types.StringValue("updated")
+
+	diags = state.SetAttribute(context.Background(), elementPath, got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected SetAttribute diagnostics: %s", diags)
+	}
+
+	expected := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.List{ElementType: elementType}, []tftypes.Value{
+			newElement("zero", "value0"),
+			newElement("one", "updated"),
+			newElement("two", "value2"),
+		}),
+	})
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
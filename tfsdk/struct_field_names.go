@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// StructWithFieldNames is an optional interface on Go struct types used with
+// Get, Set, and similar methods, which determines the attribute name of
+// struct fields without a tfsdk struct tag. Struct tags are always
+// authoritative when present, including the "-" tag to ignore a field. The
+// interface applies only to the fields of the implementing struct type, so
+// nested struct types must implement it separately.
+//
+// For example, to match struct fields by the snake_case form of the field
+// name:
+//
+//	type ThingModel struct {
+//		ExampleAttribute types.String
+//		ID               types.String `tfsdk:"thing_id"`
+//	}
+//
+//	func (m ThingModel) TFSDKFieldName(fieldName string) string {
+//		return tfsdk.SnakeCaseFieldName(fieldName)
+//	}
+type StructWithFieldNames interface {
+	// TFSDKFieldName should return the attribute name for the given Go
+	// struct field name. Returning an empty string raises the same error as
+	// a missing tfsdk struct tag.
+	TFSDKFieldName(fieldName string) string
+}
+
+// SnakeCaseFieldName returns the snake_case form of a Go struct field name,
+// such as "example_attribute" for "ExampleAttribute". Consecutive uppercase
+// letters are treated as a single word, such as "http_endpoint" for
+// "HTTPEndpoint". Use a tfsdk struct tag for any field which does not
+// convert to the expected attribute name.
+func SnakeCaseFieldName(fieldName string) string {
+	return reflect.SnakeCaseFieldName(fieldName)
+}
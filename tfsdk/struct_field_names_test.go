@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testStructWithFieldNames struct {
+	ExampleAttribute types.String
+	ID               types.String `tfsdk:"thing_id"`
+	Nested           *testStructWithFieldNamesNested
+	Ignored          string `tfsdk:"-"`
+}
+
+func (m testStructWithFieldNames) TFSDKFieldName(fieldName string) string {
+	return tfsdk.SnakeCaseFieldName(fieldName)
+}
+
+type testStructWithFieldNamesNested struct {
+	NestedAttribute types.String
+}
+
+func (m *testStructWithFieldNamesNested) TFSDKFieldName(fieldName string) string {
+	return tfsdk.SnakeCaseFieldName(fieldName)
+}
+
+func TestStructWithFieldNames(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"nested_attribute": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"example_attribute": tftypes.String,
+			"nested":            nestedType,
+			"thing_id":          tftypes.String,
+		},
+	}
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"example_attribute": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+			"nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+			"thing_id": testschema.Attribute{
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+	raw := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"example_attribute": tftypes.NewValue(tftypes.String, "example"),
+		"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"nested_attribute": tftypes.NewValue(tftypes.String, "nested"),
+		}),
+		"thing_id": tftypes.NewValue(tftypes.String, "id"),
+	})
+	data := testStructWithFieldNames{
+		ExampleAttribute: types.StringValue("example"),
+		ID:               types.StringValue("id"),
+		Nested: &testStructWithFieldNamesNested{
+			NestedAttribute: types.StringValue("nested"),
+		},
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		t.Parallel()
+
+		state := tfsdk.State{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		var got testStructWithFieldNames
+
+		diags := state.Get(context.Background(), &got)
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Fatalf("unexpected diagnostics: %s", diff)
+		}
+
+		if diff := cmp.Diff(got, data); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Parallel()
+
+		state := tfsdk.State{
+			Raw:    tftypes.NewValue(schemaType, nil),
+			Schema: schema,
+		}
+
+		diags := state.Set(context.Background(), data)
+
+		if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+			t.Fatalf("unexpected diagnostics: %s", diff)
+		}
+
+		if diff := cmp.Diff(state.Raw, raw); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	})
+
+	t.Run("missing-tag", func(t *testing.T) {
+		t.Parallel()
+
+		state := tfsdk.State{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		var got struct {
+			ExampleAttribute types.String
+			ID               types.String `tfsdk:"thing_id"`
+			Nested           *testStructWithFieldNamesNested
+		}
+
+		diags := state.Get(context.Background(), &got)
+
+		if !diags.HasError() {
+			t.Fatal("expected error diagnostic for struct field without tfsdk tag, got none")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// UnknownValue is the value set into an empty interface (interface{} or any)
+// target by Get and similar methods when the value is unknown. Empty
+// interface targets otherwise receive the natural Go type of the value: nil
+// for null, string, bool, int64 for whole numbers that fit, float64 for
+// other numbers, []interface{} for lists, sets, and tuples, and
+// map[string]interface{} for maps and objects. Collection elements follow the
+// same rules.
+//
+// For example, to check whether a struct field was unknown:
+//
+//	if _, ok := data.Example.(tfsdk.UnknownValue); ok {
+//		// ...
+//	}
+type UnknownValue = reflect.Unknown
@@ -191,7 +191,9 @@ func TestValueFrom(t *testing.T) {
 					path.Empty(),
 					diag.NewErrorDiagnostic(
 						"Value Conversion Error",
-						"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\ncan't unmarshal tftypes.Number into *string, expected string",
+						"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+							"Path: \nExpected Type: basetypes.StringType\nGiven Go Type: int",
 					),
 				),
 			},
@@ -95,7 +95,7 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s is not an integer.", value),
+			fmt.Sprintf("Value %s is not an integer.", value.Text('g', -1)),
 		)
 		return diags
 	}
@@ -106,7 +106,7 @@ func (t Int64Type) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value.Text('g', -1)),
 		)
 		return diags
 	}
@@ -139,13 +139,13 @@ func (t Int64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 	}
 
 	if !bigF.IsInt() {
-		return nil, fmt.Errorf("Value %s is not an integer.", bigF)
+		return nil, fmt.Errorf("Value %s is not an integer.", bigF.Text('g', -1))
 	}
 
 	i, accuracy := bigF.Int64()
 
 	if accuracy != 0 {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", bigF)
+		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", bigF.Text('g', -1))
 	}
 
 	return NewInt64Value(i), nil
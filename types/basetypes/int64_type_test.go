@@ -5,12 +5,81 @@ package basetypes
 
 import (
 	"context"
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestInt64TypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"null": {
+			in:       tftypes.NewValue(tftypes.Number, nil),
+			expected: nil,
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected: nil,
+		},
+		"zero": {
+			in:       tftypes.NewValue(tftypes.Number, 0),
+			expected: nil,
+		},
+		"MaxInt64": {
+			in:       tftypes.NewValue(tftypes.Number, math.MaxInt64),
+			expected: nil,
+		},
+		"MinInt64": {
+			in:       tftypes.NewValue(tftypes.Number, math.MinInt64),
+			expected: nil,
+		},
+		"MaxInt64-above": {
+			in: tftypes.NewValue(tftypes.Number, new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value 1.8446744073709551616e+19 cannot be represented as a 64-bit integer.",
+				),
+			},
+		},
+		"not-integer": {
+			in: tftypes.NewValue(tftypes.Number, 1.5),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"Value 1.5 is not an integer.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Int64Type{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64TypeValueFromTerraform(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +101,18 @@ func TestInt64TypeValueFromTerraform(t *testing.T) {
 			input:       tftypes.NewValue(tftypes.Number, nil),
 			expectation: NewInt64Null(),
 		},
+		"value-not-integer": {
+			input:       tftypes.NewValue(tftypes.Number, 1.5),
+			expectedErr: "Value 1.5 is not an integer.",
+		},
+		"value-too-large": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))),
+			expectedErr: "Value 1.8446744073709551616e+19 cannot be represented as a 64-bit integer.",
+		},
+		"value-too-small": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetInt(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 64)))),
+			expectedErr: "Value -1.8446744073709551616e+19 cannot be represented as a 64-bit integer.",
+		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
 			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
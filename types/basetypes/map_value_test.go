@@ -163,8 +163,9 @@ func TestNewMapValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty(),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"can't use tftypes.String<\"oops\"> as value of MapValue, can only use tftypes.Map values",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: \nExpected Type: types.MapType[basetypes.StringType]\nGiven Go Type: string",
 				),
 			},
 		},
@@ -176,8 +177,9 @@ func TestNewMapValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty().AtMapKey("key1"),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"can't unmarshal tftypes.Bool into *string, expected string",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: [\"key1\"]\nExpected Type: basetypes.StringType\nGiven Go Type: bool",
 				),
 			},
 		},
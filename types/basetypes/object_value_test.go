@@ -286,8 +286,9 @@ func TestNewObjectValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty(),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"expected tftypes.Object[\"bool\":tftypes.Bool, \"string\":tftypes.String], got tftypes.String",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: \nExpected Type: types.ObjectType[\"bool\":basetypes.BoolType, \"string\":basetypes.StringType]\nGiven Go Type: string",
 				),
 			},
 		},
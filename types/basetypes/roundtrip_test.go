@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// FuzzValueRoundTrip verifies that converting a randomly generated value to
+// the terraform-plugin-go type system with ToTerraformValue and back with
+// ValueFromTerraform returns an equal value. Each seed deterministically
+// generates a value of any type, including null, unknown, and nested values.
+// The seed corpus is run with go test, while additional seeds are generated
+// with go test -fuzz=FuzzValueRoundTrip.
+func FuzzValueRoundTrip(f *testing.F) {
+	for seed := int64(0); seed < 500; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		ctx := context.Background()
+		r := rand.New(rand.NewSource(seed))
+
+		typ := roundTripType(r, 3, roundTripTypeOptions{allowDynamic: true, allowNonCanonical: true})
+		value := roundTripValue(r, typ)
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			t.Fatalf("unexpected ToTerraformValue error for %s: %s", value, err)
+		}
+
+		got, err := typ.ValueFromTerraform(ctx, tfValue)
+
+		if err != nil {
+			t.Fatalf("unexpected ValueFromTerraform error for %s: %s", tfValue, err)
+		}
+
+		if !got.Equal(value) {
+			t.Errorf("expected round trip of %s to equal %s, got: %s", typ, value, got)
+		}
+
+		if !got.Type(ctx).Equal(typ) {
+			t.Errorf("expected round trip of %s to have type %s, got: %s", value, typ, got.Type(ctx))
+		}
+	})
+}
+
+// roundTripTypeOptions controls which types are generated by roundTripType.
+type roundTripTypeOptions struct {
+	// allowDynamic enables DynamicType. Dynamic values inside collections
+	// must share a single concrete type, so this is disabled underneath
+	// collection and tuple types.
+	allowDynamic bool
+
+	// allowNonCanonical enables Float64Type and Int64Type. The underlying
+	// value of a DynamicValue is always converted from the Terraform type,
+	// which is represented as NumberType, so this is disabled underneath
+	// DynamicType.
+	allowNonCanonical bool
+}
+
+// roundTripType returns a random type, nesting collection, object, and tuple
+// types up to the given depth.
+func roundTripType(r *rand.Rand, depth int, opts roundTripTypeOptions) attr.Type {
+	var candidates []func() attr.Type
+
+	candidates = append(candidates,
+		func() attr.Type { return BoolType{} },
+		func() attr.Type { return NumberType{} },
+		func() attr.Type { return StringType{} },
+	)
+
+	if opts.allowNonCanonical {
+		candidates = append(candidates,
+			func() attr.Type { return Float64Type{} },
+			func() attr.Type { return Int64Type{} },
+		)
+	}
+
+	if opts.allowDynamic {
+		candidates = append(candidates, func() attr.Type { return DynamicType{} })
+	}
+
+	if depth > 0 {
+		elemOpts := roundTripTypeOptions{allowNonCanonical: opts.allowNonCanonical}
+
+		candidates = append(candidates,
+			func() attr.Type { return ListType{ElemType: roundTripType(r, depth-1, elemOpts)} },
+			func() attr.Type { return MapType{ElemType: roundTripType(r, depth-1, elemOpts)} },
+			func() attr.Type { return SetType{ElemType: roundTripType(r, depth-1, elemOpts)} },
+			func() attr.Type {
+				attrTypes := make(map[string]attr.Type)
+				attrCount := r.Intn(4)
+
+				for i := 0; i < attrCount; i++ {
+					attrTypes[fmt.Sprintf("attr%d", i)] = roundTripType(r, depth-1, opts)
+				}
+
+				return ObjectType{AttrTypes: attrTypes}
+			},
+			func() attr.Type {
+				var elemTypes []attr.Type
+				elemCount := r.Intn(4)
+
+				for i := 0; i < elemCount; i++ {
+					elemTypes = append(elemTypes, roundTripType(r, depth-1, elemOpts))
+				}
+
+				return TupleType{ElemTypes: elemTypes}
+			},
+		)
+	}
+
+	return candidates[r.Intn(len(candidates))]()
+}
+
+// roundTripValue returns a random value of the given type. Roughly one in ten
+// values, including nested values, are null or unknown.
+func roundTripValue(r *rand.Rand, typ attr.Type) attr.Value {
+	nullOrUnknown := r.Intn(10)
+
+	switch typ := typ.(type) {
+	case BoolType:
+		switch nullOrUnknown {
+		case 0:
+			return NewBoolNull()
+		case 1:
+			return NewBoolUnknown()
+		}
+
+		return NewBoolValue(r.Intn(2) == 0)
+	case DynamicType:
+		switch nullOrUnknown {
+		case 0:
+			return NewDynamicNull()
+		case 1:
+			return NewDynamicUnknown()
+		}
+
+		underlyingType := roundTripType(r, 2, roundTripTypeOptions{})
+
+		return NewDynamicValue(roundTripValue(r, underlyingType))
+	case Float64Type:
+		switch nullOrUnknown {
+		case 0:
+			return NewFloat64Null()
+		case 1:
+			return NewFloat64Unknown()
+		}
+
+		return NewFloat64Value(r.NormFloat64() * 1e6)
+	case Int64Type:
+		switch nullOrUnknown {
+		case 0:
+			return NewInt64Null()
+		case 1:
+			return NewInt64Unknown()
+		}
+
+		return NewInt64Value(r.Int63() - r.Int63())
+	case ListType:
+		switch nullOrUnknown {
+		case 0:
+			return NewListNull(typ.ElemType)
+		case 1:
+			return NewListUnknown(typ.ElemType)
+		}
+
+		return NewListValueMust(typ.ElemType, roundTripElements(r, typ.ElemType))
+	case MapType:
+		switch nullOrUnknown {
+		case 0:
+			return NewMapNull(typ.ElemType)
+		case 1:
+			return NewMapUnknown(typ.ElemType)
+		}
+
+		elements := make(map[string]attr.Value)
+
+		for _, element := range roundTripElements(r, typ.ElemType) {
+			elements[roundTripString(r)] = element
+		}
+
+		return NewMapValueMust(typ.ElemType, elements)
+	case NumberType:
+		switch nullOrUnknown {
+		case 0:
+			return NewNumberNull()
+		case 1:
+			return NewNumberUnknown()
+		}
+
+		return NewNumberValue(big.NewFloat(r.NormFloat64() * 1e6))
+	case ObjectType:
+		switch nullOrUnknown {
+		case 0:
+			return NewObjectNull(typ.AttrTypes)
+		case 1:
+			return NewObjectUnknown(typ.AttrTypes)
+		}
+
+		attributes := make(map[string]attr.Value, len(typ.AttrTypes))
+
+		for name, attrType := range typ.AttrTypes {
+			attributes[name] = roundTripValue(r, attrType)
+		}
+
+		return NewObjectValueMust(typ.AttrTypes, attributes)
+	case SetType:
+		switch nullOrUnknown {
+		case 0:
+			return NewSetNull(typ.ElemType)
+		case 1:
+			return NewSetUnknown(typ.ElemType)
+		}
+
+		return NewSetValueMust(typ.ElemType, roundTripElements(r, typ.ElemType))
+	case StringType:
+		switch nullOrUnknown {
+		case 0:
+			return NewStringNull()
+		case 1:
+			return NewStringUnknown()
+		}
+
+		return NewStringValue(roundTripString(r))
+	case TupleType:
+		switch nullOrUnknown {
+		case 0:
+			return NewTupleNull(typ.ElemTypes)
+		case 1:
+			return NewTupleUnknown(typ.ElemTypes)
+		}
+
+		elements := make([]attr.Value, 0, len(typ.ElemTypes))
+
+		for _, elemType := range typ.ElemTypes {
+			elements = append(elements, roundTripValue(r, elemType))
+		}
+
+		return NewTupleValueMust(typ.ElemTypes, elements)
+	default:
+		panic(fmt.Sprintf("unsupported round trip type: %T", typ))
+	}
+}
+
+// roundTripElements returns up to three random values of the element type.
+func roundTripElements(r *rand.Rand, elemType attr.Type) []attr.Value {
+	var elements []attr.Value
+	elemCount := r.Intn(4)
+
+	for i := 0; i < elemCount; i++ {
+		elements = append(elements, roundTripValue(r, elemType))
+	}
+
+	return elements
+}
+
+// roundTripString returns a random string, which may be empty or contain
+// multibyte and whitespace characters.
+func roundTripString(r *rand.Rand) string {
+	runes := []rune("abcXYZ019 \t\n\"\\é日本")
+	result := make([]rune, r.Intn(8))
+
+	for i := range result {
+		result[i] = runes[r.Intn(len(runes))]
+	}
+
+	return string(result)
+}
@@ -446,8 +446,9 @@ func TestNewSetValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty(),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"can't use tftypes.String<\"oops\"> as value of Set with ElementType basetypes.StringType, can only use tftypes.String values",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: \nExpected Type: types.SetType[basetypes.StringType]\nGiven Go Type: string",
 				),
 			},
 		},
@@ -459,8 +460,9 @@ func TestNewSetValueFrom(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Empty().AtListIndex(0),
 					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"can't unmarshal tftypes.Bool into *string, expected string",
+					"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The Go value type cannot be converted into the schema type. Use a Go value or framework type matching the schema type.\n\n"+
+						"Path: [0]\nExpected Type: basetypes.StringType\nGiven Go Type: bool",
 				),
 			},
 		},